@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"go/format"
 	"os"
+	"strconv"
 	"strings"
 )
 
@@ -25,8 +26,9 @@ type Exception struct {
 }
 
 // extractExceptionLicenseIDs read official exception licenses file copied from spdx/license-list-data
-// and write file exception_license_ids.json containing just the non-deprecated exception license IDs.
-// NOTE: For now, this function ignores the deprecated exception licenses.
+// and write files get_exceptions.go and get_deprecated_exceptions.go containing just the exception
+// license IDs and deprecated exception license IDs, respectively.  It also writes exception_info.go
+// containing the metadata of all exceptions.
 func extractExceptionLicenseIDs() error {
 	// open file
 	file, err := os.Open("exceptions.json")
@@ -42,10 +44,13 @@ func extractExceptionLicenseIDs() error {
 		return err
 	}
 
-	// create slice of exception license IDs that are not deprecated
+	// create two slices of exception license IDs, one for deprecated and one for active
 	var exceptionLicenseIDs []string
+	var deprecatedExceptionIDs []string
 	for _, e := range exceptionData.Exceptions {
-		if !e.IsDeprecated {
+		if e.IsDeprecated {
+			deprecatedExceptionIDs = append(deprecatedExceptionIDs, e.LicenseID)
+		} else {
 			exceptionLicenseIDs = append(exceptionLicenseIDs, e.LicenseID)
 		}
 	}
@@ -110,5 +115,171 @@ func GetExceptions() []string {
 	}
 	fmt.Println("Writing `../spdxexp/spdxlicenses/get_exceptions.go`... COMPLETE")
 
+	// generate the GetDeprecatedExceptions() function in get_deprecated_exceptions.go
+	getDeprecatedExceptionsContents := []byte(`package spdxlicenses
+
+// Code generated by go-spdx cmd/exceptions.go. DO NOT EDIT.
+// Source: https://github.com/spdx/license-list-data specifies official SPDX license list.
+
+import "strings"
+
+// IsDeprecatedException does a case-insensitive lookup for the exception id in the deprecated exceptions map.
+// It returns true and the case-sensitive ID if found, otherwise false and the original id.
+func IsDeprecatedException(id string) (bool, string) {
+	foundID, ok := deprecatedExceptionsMap[strings.ToUpper(id)]
+	if ok {
+		return true, foundID
+	}
+	return false, id
+}
+
+// GetDeprecatedExceptionsMap returns a map of deprecated exception license IDs keyed by uppercase ID.
+func GetDeprecatedExceptionsMap() map[string]string {
+	copied := make(map[string]string, len(deprecatedExceptionsMap))
+	for k, v := range deprecatedExceptionsMap {
+		copied[k] = v
+	}
+	return copied
+}
+
+// GetDeprecatedExceptions returns a slice of deprecated exception license IDs.
+func GetDeprecatedExceptions() []string {
+	return []string{
+`)
+	for _, id := range deprecatedExceptionIDs {
+		getDeprecatedExceptionsContents = append(getDeprecatedExceptionsContents, `		"`+id+`",
+`...)
+	}
+	getDeprecatedExceptionsContents = append(getDeprecatedExceptionsContents, `	}
+}
+
+`...)
+
+	getDeprecatedExceptionsContents = append(getDeprecatedExceptionsContents, `var deprecatedExceptionsMap = map[string]string{
+`...)
+	for _, id := range deprecatedExceptionIDs {
+		getDeprecatedExceptionsContents = append(getDeprecatedExceptionsContents, `		"`+strings.ToUpper(id)+`": "`+id+`",
+`...)
+	}
+	getDeprecatedExceptionsContents = append(getDeprecatedExceptionsContents, `}
+`...)
+
+	getDeprecatedExceptionsContents, err = format.Source(getDeprecatedExceptionsContents)
+	if err != nil {
+		return fmt.Errorf("format generated get_deprecated_exceptions.go: %w", err)
+	}
+
+	err = os.WriteFile("../spdxexp/spdxlicenses/get_deprecated_exceptions.go", getDeprecatedExceptionsContents, 0600)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Writing `../spdxexp/spdxlicenses/get_deprecated_exceptions.go`... COMPLETE")
+
+	return extractExceptionInfo(exceptionData.Exceptions)
+}
+
+// extractExceptionInfo writes exception_info.go containing the ExceptionInfo of each active and
+// deprecated exception, and the LookupException() function to find it by exception id.  The
+// licenses that an exception is commonly used with are not in the SPDX exception list, so they
+// are read from exception_licenses.json, which is maintained by hand.  It returns an error if it
+// encounters one.
+func extractExceptionInfo(exceptions []Exception) error {
+	file, err := os.Open("exception_licenses.json")
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// read in the licenses that each exception is commonly used with, keyed by exception id
+	var usedWith map[string][]string
+	err = json.NewDecoder(file).Decode(&usedWith)
+	if err != nil {
+		return err
+	}
+	for id := range usedWith {
+		found := false
+		for _, e := range exceptions {
+			found = found || e.LicenseID == id
+		}
+		if !found {
+			return fmt.Errorf("exception_licenses.json has unknown exception id %q", id)
+		}
+	}
+
+	exceptionInfoContents := []byte(`package spdxlicenses
+
+// Code generated by go-spdx cmd/exceptions.go. DO NOT EDIT.
+// Source: https://github.com/spdx/license-list-data specifies official SPDX license list.
+
+import "strings"
+
+// ExceptionInfo is the metadata of an exception in the SPDX exception list.
+type ExceptionInfo struct {
+	// ID is the case-sensitive SPDX exception id.
+	ID string
+	// Name is the full name of the exception.
+	Name string
+	// IsDeprecated is true if the exception id is deprecated.
+	IsDeprecated bool
+	// SeeAlso are URLs of other references to the exception text.
+	SeeAlso []string
+	// UsedWith are the licenses that the exception is commonly used with.  It is empty when they
+	// are not known.
+	UsedWith []string
+	// Reference is the URL of the exception on spdx.org.
+	Reference string
+}
+
+// LookupException does a case-insensitive lookup for the exception id in the active and deprecated
+// exceptions.  It returns the metadata of the exception and true if found, otherwise false.
+func LookupException(id string) (ExceptionInfo, bool) {
+	info, ok := exceptionInfoMap[strings.ToUpper(id)]
+	if !ok {
+		return ExceptionInfo{}, false
+	}
+	info.SeeAlso = append([]string(nil), info.SeeAlso...)
+	info.UsedWith = append([]string(nil), info.UsedWith...)
+	return info, true
+}
+
+var exceptionInfoMap = map[string]ExceptionInfo{
+`)
+	for _, e := range exceptions {
+		exceptionInfoContents = append(exceptionInfoContents, `		`+strconv.Quote(strings.ToUpper(e.LicenseID))+`: {
+			ID: `+strconv.Quote(e.LicenseID)+`,
+			Name: `+strconv.Quote(e.Name)+`,
+			IsDeprecated: `+strconv.FormatBool(e.IsDeprecated)+`,
+			SeeAlso: []string{
+`...)
+		for _, url := range e.SeeAlso {
+			exceptionInfoContents = append(exceptionInfoContents, `				`+strconv.Quote(url)+`,
+`...)
+		}
+		exceptionInfoContents = append(exceptionInfoContents, `			},
+			UsedWith: []string{
+`...)
+		for _, license := range usedWith[e.LicenseID] {
+			exceptionInfoContents = append(exceptionInfoContents, `				`+strconv.Quote(license)+`,
+`...)
+		}
+		exceptionInfoContents = append(exceptionInfoContents, `			},
+			Reference: `+strconv.Quote(e.Reference)+`,
+		},
+`...)
+	}
+	exceptionInfoContents = append(exceptionInfoContents, `}
+`...)
+
+	exceptionInfoContents, err = format.Source(exceptionInfoContents)
+	if err != nil {
+		return fmt.Errorf("format generated exception_info.go: %w", err)
+	}
+
+	err = os.WriteFile("../spdxexp/spdxlicenses/exception_info.go", exceptionInfoContents, 0600)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Writing `../spdxexp/spdxlicenses/exception_info.go`... COMPLETE")
+
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"go/format"
 	"os"
+	"strconv"
 	"strings"
 )
 
@@ -22,11 +23,14 @@ type License struct {
 	LicenseID       string   `json:"licenseId"`
 	SeeAlso         []string `json:"seeAlso"`
 	IsOsiApproved   bool     `json:"isOsiApproved"`
+	IsFsfLibre      bool     `json:"isFsfLibre"`
 }
 
 // extractLicenseIDs reads the official licenses.json file copied from spdx/license-list-data
 // and writes two files, license_ids.json and deprecated_license_ids.json, containing just
-// the license IDs and deprecated license IDs, respectively.  It returns an error if it
+// the license IDs and deprecated license IDs, respectively.  It also writes
+// deprecated_replacements.go containing the replacements of deprecated license IDs, and
+// license_info.go containing the metadata of all licenses.  It returns an error if it
 // encounters one.
 func extractLicenseIDs() error {
 	// open file
@@ -175,5 +179,218 @@ func GetDeprecated() []string {
 	}
 	fmt.Println("Writing `../spdxexp/spdxlicenses/get_deprecated.go`... COMPLETE")
 
+	err = extractDeprecatedReplacements(activeLicenseIDs, deprecatedLicenseIDs)
+	if err != nil {
+		return err
+	}
+	err = extractLicenseInfo(licenseData.Licenses)
+	if err != nil {
+		return err
+	}
+	return extractVersion(licenseData.Version)
+}
+
+// extractVersion writes version.go containing the Version() function, which returns the version
+// of the SPDX license list that the package is generated from.  It returns an error if it
+// encounters one.
+func extractVersion(version string) error {
+	if version == "" {
+		return fmt.Errorf("licenses.json does not have a licenseListVersion")
+	}
+
+	versionContents := []byte(`package spdxlicenses
+
+// Code generated by go-spdx cmd/license.go. DO NOT EDIT.
+// Source: https://github.com/spdx/license-list-data specifies official SPDX license list.
+
+// Version returns the licenseListVersion of the SPDX license list that the licenses, deprecated
+// licenses, and exceptions are generated from.
+func Version() string {
+	return licenseListVersion
+}
+
+const licenseListVersion = ` + strconv.Quote(version) + `
+`)
+
+	versionContents, err := format.Source(versionContents)
+	if err != nil {
+		return fmt.Errorf("format generated version.go: %w", err)
+	}
+
+	err = os.WriteFile("../spdxexp/spdxlicenses/version.go", versionContents, 0600)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Writing `../spdxexp/spdxlicenses/version.go`... COMPLETE")
+
+	return nil
+}
+
+// extractDeprecatedReplacements writes deprecated_replacements.go containing the SPDX expression
+// that replaces each deprecated license id that has a replacement.  Deprecated ids of licenses
+// with -only and -or-later variants are replaced by the variant (e.g. "GPL-2.0" is "GPL-2.0-only"
+// and "GPL-2.0+" is "GPL-2.0-or-later").  The other replacements are not in the SPDX license
+// list, so they are read from deprecated_replacements.json, which is maintained by hand.  It
+// returns an error if it encounters one.
+func extractDeprecatedReplacements(activeLicenseIDs, deprecatedLicenseIDs []string) error {
+	file, err := os.Open("deprecated_replacements.json")
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// read in the replacements that cannot be derived from the license ids, keyed by deprecated id
+	var replacements map[string]string
+	err = json.NewDecoder(file).Decode(&replacements)
+	if err != nil {
+		return err
+	}
+
+	active := make(map[string]bool, len(activeLicenseIDs))
+	for _, id := range activeLicenseIDs {
+		active[id] = true
+	}
+	deprecated := make(map[string]bool, len(deprecatedLicenseIDs))
+	for _, id := range deprecatedLicenseIDs {
+		deprecated[id] = true
+	}
+	for id := range replacements {
+		if !deprecated[id] {
+			return fmt.Errorf("deprecated_replacements.json has id %q that is not a deprecated license", id)
+		}
+	}
+
+	getReplacementsContents := []byte(`package spdxlicenses
+
+// Code generated by go-spdx cmd/license.go. DO NOT EDIT.
+// Source: https://github.com/spdx/license-list-data specifies official SPDX license list.
+
+import "strings"
+
+// DeprecatedReplacement does a case-insensitive lookup for the deprecated license id in the replacements map.
+// It returns true and the SPDX expression that replaces the license if found, otherwise false and "".
+func DeprecatedReplacement(id string) (bool, string) {
+	replacement, ok := deprecatedReplacementsMap[strings.ToUpper(id)]
+	return ok, replacement
+}
+
+// GetDeprecatedReplacementsMap returns a map of the expressions that replace deprecated license IDs keyed by
+// uppercase deprecated license ID.
+func GetDeprecatedReplacementsMap() map[string]string {
+	copied := make(map[string]string, len(deprecatedReplacementsMap))
+	for k, v := range deprecatedReplacementsMap {
+		copied[k] = v
+	}
+	return copied
+}
+
+var deprecatedReplacementsMap = map[string]string{
+`)
+	for _, id := range deprecatedLicenseIDs {
+		replacement, ok := replacements[id]
+		switch {
+		case ok:
+		case strings.HasSuffix(id, "+") && active[strings.TrimSuffix(id, "+")+"-or-later"]:
+			replacement = strings.TrimSuffix(id, "+") + "-or-later"
+		case active[id+"-only"]:
+			replacement = id + "-only"
+		default:
+			// the deprecated license has no replacement (e.g. Net-SNMP)
+			continue
+		}
+		getReplacementsContents = append(getReplacementsContents, `		"`+strings.ToUpper(id)+`": "`+replacement+`",
+`...)
+	}
+	getReplacementsContents = append(getReplacementsContents, `}
+`...)
+
+	getReplacementsContents, err = format.Source(getReplacementsContents)
+	if err != nil {
+		return fmt.Errorf("format generated deprecated_replacements.go: %w", err)
+	}
+
+	err = os.WriteFile("../spdxexp/spdxlicenses/deprecated_replacements.go", getReplacementsContents, 0600)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Writing `../spdxexp/spdxlicenses/deprecated_replacements.go`... COMPLETE")
+
+	return nil
+}
+
+// extractLicenseInfo writes license_info.go containing the LicenseInfo of each active and
+// deprecated license, and the Lookup() function to find it by license id.  It returns an
+// error if it encounters one.
+func extractLicenseInfo(licenses []License) error {
+	licenseInfoContents := []byte(`package spdxlicenses
+
+// Code generated by go-spdx cmd/license.go. DO NOT EDIT.
+// Source: https://github.com/spdx/license-list-data specifies official SPDX license list.
+
+import "strings"
+
+// LicenseInfo is the metadata of a license in the SPDX license list.
+type LicenseInfo struct {
+	// ID is the case-sensitive SPDX license id.
+	ID string
+	// Name is the full name of the license.
+	Name string
+	// IsOsiApproved is true if the license is approved by the Open Source Initiative.
+	IsOsiApproved bool
+	// IsFsfLibre is true if the license is listed as free by the Free Software Foundation.
+	IsFsfLibre bool
+	// IsDeprecated is true if the license id is deprecated.
+	IsDeprecated bool
+	// SeeAlso are URLs of other references to the license text.
+	SeeAlso []string
+	// Reference is the URL of the license on spdx.org.
+	Reference string
+}
+
+// Lookup does a case-insensitive lookup for the license id in the active and deprecated licenses.
+// It returns the metadata of the license and true if found, otherwise false.
+func Lookup(id string) (LicenseInfo, bool) {
+	info, ok := licenseInfoMap[strings.ToUpper(id)]
+	if !ok {
+		return LicenseInfo{}, false
+	}
+	info.SeeAlso = append([]string(nil), info.SeeAlso...)
+	return info, true
+}
+
+var licenseInfoMap = map[string]LicenseInfo{
+`)
+	for _, l := range licenses {
+		licenseInfoContents = append(licenseInfoContents, `		`+strconv.Quote(strings.ToUpper(l.LicenseID))+`: {
+			ID: `+strconv.Quote(l.LicenseID)+`,
+			Name: `+strconv.Quote(l.Name)+`,
+			IsOsiApproved: `+strconv.FormatBool(l.IsOsiApproved)+`,
+			IsFsfLibre: `+strconv.FormatBool(l.IsFsfLibre)+`,
+			IsDeprecated: `+strconv.FormatBool(l.IsDeprecated)+`,
+			SeeAlso: []string{
+`...)
+		for _, url := range l.SeeAlso {
+			licenseInfoContents = append(licenseInfoContents, `				`+strconv.Quote(url)+`,
+`...)
+		}
+		licenseInfoContents = append(licenseInfoContents, `			},
+			Reference: `+strconv.Quote(l.Reference)+`,
+		},
+`...)
+	}
+	licenseInfoContents = append(licenseInfoContents, `}
+`...)
+
+	licenseInfoContents, err := format.Source(licenseInfoContents)
+	if err != nil {
+		return fmt.Errorf("format generated license_info.go: %w", err)
+	}
+
+	err = os.WriteFile("../spdxexp/spdxlicenses/license_info.go", licenseInfoContents, 0600)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Writing `../spdxexp/spdxlicenses/license_info.go`... COMPLETE")
+
 	return nil
 }
@@ -3,6 +3,7 @@ package main
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -76,6 +77,7 @@ func validateExpressions(r io.Reader, w io.Writer) (bool, error) {
 		if !valid {
 			failures++
 			_, _ = fmt.Fprintf(w, "line %d: invalid SPDX expression: %q\n", lineNum, line)
+			writeDiagnostics(w, line)
 		}
 	}
 
@@ -95,6 +97,24 @@ func validateExpressions(r io.Reader, w io.Writer) (bool, error) {
 	return true, nil
 }
 
+// writeDiagnostics writes every problem found in an invalid expression to w, one per line,
+// with the byte offset where the problem was found and suggestions for unknown licenses.
+func writeDiagnostics(w io.Writer, line string) {
+	_, err := spdxexp.ParseWithOptions(line, spdxexp.ParseOptions{Recover: true})
+	var parseErrs spdxexp.ParseErrors
+	if !errors.As(err, &parseErrs) {
+		return
+	}
+	for _, parseErr := range parseErrs {
+		// some messages already end with the offset, which is only written once
+		msg := strings.TrimSuffix(parseErr.Error(), fmt.Sprintf(" at offset %d", parseErr.Offset))
+		_, _ = fmt.Fprintf(w, "  offset %d: %s\n", parseErr.Offset, msg)
+		if suggestions := parseErr.Suggestions(); len(suggestions) > 0 {
+			_, _ = fmt.Fprintf(w, "    did you mean: %s?\n", strings.Join(suggestions, ", "))
+		}
+	}
+}
+
 func main() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -222,3 +222,51 @@ func TestValidateExpressions_FromTempFileWithFailures(t *testing.T) {
 		t.Errorf("expected summary in output, got: %s", output)
 	}
 }
+
+func TestValidateExpressions_ReportsAllDiagnostics(t *testing.T) {
+	input := "MIT\nMIT ANDD (Apache-2.0 OR FOO\n"
+	r := strings.NewReader(input)
+	var w bytes.Buffer
+	ok, err := validateExpressions(r, &w)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ok {
+		t.Error("expected invalid result, got valid")
+	}
+	output := w.String()
+	for _, diagnostic := range []string{
+		"offset 4: unknown license 'ANDD'",
+		"offset 24: unknown license 'FOO'",
+		"offset 27: open parenthesis does not have a matching close parenthesis",
+	} {
+		if !strings.Contains(output, diagnostic+"\n") {
+			t.Errorf("expected %q in output, got: %s", diagnostic, output)
+		}
+	}
+	if strings.Contains(output, "at offset") {
+		t.Errorf("expected each offset once in output, got: %s", output)
+	}
+}
+
+func TestValidateExpressions_ReportsSuggestions(t *testing.T) {
+	input := "MIT\nApache2 OR BDS-3-Clause\n"
+	r := strings.NewReader(input)
+	var w bytes.Buffer
+	ok, err := validateExpressions(r, &w)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ok {
+		t.Error("expected invalid result, got valid")
+	}
+	output := w.String()
+	for _, suggestion := range []string{
+		"did you mean: Apache-2.0?",
+		"did you mean: BSD-3-Clause,",
+	} {
+		if !strings.Contains(output, suggestion) {
+			t.Errorf("expected %q in output, got: %s", suggestion, output)
+		}
+	}
+}
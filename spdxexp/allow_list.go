@@ -0,0 +1,282 @@
+package spdxexp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// AllowListError describes an entry in a list of licenses that could not be compiled.
+type AllowListError struct {
+	// List is the name of the list with the entry (e.g. "allowedList").
+	List string
+	// Index is the position of the entry in the list.
+	Index int
+	// Entry is the entry as it appears in the list.
+	Entry string
+	// Err is the reason the entry is invalid, which is a parse error, an invalid pattern, or a
+	// *NormalFormLimitError for an expression with too many licenses.
+	Err error
+}
+
+// Error returns a human readable description of the error.
+func (e *AllowListError) Error() string {
+	return fmt.Sprintf("invalid %s entry %q at index %d: %v", e.List, e.Entry, e.Index, e.Err)
+}
+
+// Unwrap returns the reason the entry is invalid.
+func (e *AllowListError) Unwrap() error {
+	return e.Err
+}
+
+// AllowList is a list of allowed licenses that has been parsed, validated, and indexed by
+// CompileAllowList, so that it can be used to check many license expressions without repeating
+// that work.  An AllowList is not changed after it is compiled, so it is safe for concurrent use.
+type AllowList struct {
+	// allowed licenses, sorted and deduped
+	allowed []*node
+	// exact indexes the allowed licenses by their lowercase reconstructed license string, so that
+	// exact matches are found without checking every allowed license.
+	exact map[string][]*node
+	// bundles of licenses that are only allowed together, from entries with AND
+	bundles [][]*node
+	// patterns of licenses with wildcards
+	patterns []*licensePattern
+	// entries maps each allowed license, including licenses in bundles, to its entry in the
+	// allowed list
+	entries map[*node]string
+	// licenses is the license list that the allowed list was compiled with, or nil for the default
+	// list
+	licenses *LicenseList
+}
+
+// AllowListOptions controls how CompileAllowListWithOptions compiles the allowed list.
+type AllowListOptions struct {
+	// LicenseList is the license list that licenses in the allowed list are looked up in.  It is
+	// also used for test expressions, unless SatisfiesOptions has a LicenseList.  When nil, the list
+	// embedded in the spdxlicenses package is used.  License ranges are always from the embedded
+	// list, as with SatisfiesOptions.
+	LicenseList *LicenseList
+}
+
+// CompileAllowList parses and validates each license in the allowed list.
+//
+// Entries can be expressions.  Each license that is ORed in an entry is allowed on its own, and
+// licenses that are ANDed are a bundle that is only allowed together (e.g. "MIT AND Apache-2.0"
+// allows "MIT AND Apache-2.0", but not "MIT").  An expression is satisfied when, for one of its
+// ORed branches, each license is compatible with an allowed license or with a license in a bundle
+// whose licenses are all compatible with licenses in the branch.
+//
+// Entries can also be patterns, where * matches any sequence of characters.  A pattern is a single
+// license id (e.g. "BSD-*"), license ref (e.g. "LicenseRef-*", "DocumentRef-internal:*"), or either
+// of them WITH an exception or addition (e.g. "GPL-2.0-only WITH *").  Patterns of license and
+// exception ids must match at least one SPDX license or exception.  A license with + is matched by
+// a pattern that matches it or any later version in its range.
+// Returns error if the list is empty, or an *AllowListError identifying the first invalid entry.
+func CompileAllowList(allowedList []string) (*AllowList, error) {
+	return CompileAllowListWithOptions(allowedList, AllowListOptions{})
+}
+
+// CompileAllowListWithOptions parses and validates each license in the allowed list, like
+// CompileAllowList.  Supports options as defined in AllowListOptions.
+func CompileAllowListWithOptions(allowedList []string, options AllowListOptions) (*AllowList, error) {
+	return compileAllowList(allowedList, options.LicenseList)
+}
+
+// compileAllowList compiles a non-empty allowed list, looking up ids in the license list, or the
+// default list if it is nil.
+func compileAllowList(allowedList []string, licenses *LicenseList) (*AllowList, error) {
+	if len(allowedList) == 0 {
+		return nil, errors.New("allowedList requires at least one element, but is empty")
+	}
+	return compileLicenseList("allowedList", allowedList, licenses)
+}
+
+// compileLicenseList compiles a list of licenses, which may be empty, looking up ids in the
+// license list, or the default list if it is nil.  The name of the list is used in errors.
+func compileLicenseList(name string, allowedList []string, licenses *LicenseList) (*AllowList, error) {
+	var allowed []*node
+	var bundles [][]*node
+	var patterns []*licensePattern
+	entries := make(map[*node]string, len(allowedList))
+	for i, entry := range allowedList {
+		if isPattern(entry) {
+			pattern, err := parsePattern(entry, licenses)
+			if err != nil {
+				return nil, &AllowListError{List: name, Index: i, Entry: entry, Err: err}
+			}
+			patterns = append(patterns, pattern)
+			continue
+		}
+
+		n, err := parseWithLicenses(entry, licenses)
+		if err != nil {
+			return nil, &AllowListError{List: name, Index: i, Entry: entry, Err: err}
+		}
+		if !n.isExpression() {
+			allowed = append(allowed, n)
+			entries[n] = entry
+			continue
+		}
+
+		branches, err := dnfBranches(n)
+		if err != nil {
+			return nil, &AllowListError{List: name, Index: i, Entry: entry, Err: err}
+		}
+		for _, branch := range branches {
+			for _, license := range branch {
+				entries[license] = entry
+			}
+			if len(branch) == 1 {
+				allowed = append(allowed, branch[0])
+			} else {
+				bundles = append(bundles, branch)
+			}
+		}
+	}
+	allowed = sortAndDedup(allowed)
+
+	exact := make(map[string][]*node, len(allowed))
+	for _, n := range allowed {
+		key := strings.ToLower(*n.reconstructedLicenseString())
+		exact[key] = append(exact[key], n)
+	}
+	return &AllowList{allowed: allowed, exact: exact, bundles: bundles, patterns: patterns, entries: entries, licenses: licenses}, nil
+}
+
+// Satisfies determines if the allowed list satisfies the test license expression.
+// Returns true if allowed list satisfies test license expression; otherwise, false.
+// Returns error if the test expression cannot be parsed.
+func (a *AllowList) Satisfies(testExpression string) (bool, error) {
+	return a.SatisfiesWithOptions(testExpression, SatisfiesOptions{})
+}
+
+// SatisfiesWithOptions determines if the allowed list satisfies the test license expression.
+// Supports options as defined in SatisfiesOptions.  When options.LicenseList is nil, the test
+// expression is parsed with the license list that the allowed list was compiled with.
+// Returns true if allowed list satisfies test license expression; otherwise, false.
+// Returns error if the test expression cannot be parsed.
+func (a *AllowList) SatisfiesWithOptions(testExpression string, options SatisfiesOptions) (bool, error) {
+	testExpression = strings.TrimSpace(testExpression)
+	if value, ok := specialValue(testExpression); ok {
+		if err := options.SpecialValues.check(value); err != nil {
+			return false, err
+		}
+		_, ok := a.exact[strings.ToLower(value)]
+		return ok, nil
+	}
+
+	expressionNode, err := parseWithLicenses(testExpression, a.licenseList(options))
+	if err != nil {
+		return false, err
+	}
+	return a.satisfies(expressionNode), nil
+}
+
+// licenseList returns the license list that test expressions are parsed with, which is the list
+// in the options, or the list that the allowed list was compiled with.
+func (a *AllowList) licenseList(options SatisfiesOptions) *LicenseList {
+	if options.LicenseList != nil {
+		return options.LicenseList
+	}
+	return a.licenses
+}
+
+// satisfies determines if the allowed list satisfies a parsed expression.  Without bundles, each
+// license is allowed or not regardless of the other licenses, so the expression tree is evaluated
+// directly.  Bundles depend on the other licenses in a branch, so the bundles that can be complete
+// in the expression are evaluated with the states of its branches.
+func (a *AllowList) satisfies(n *node) bool {
+	if len(a.bundles) > 0 {
+		if e := newBundleEvaluator(n, Denied, nil, nil, a); len(e.bundles) > 0 {
+			return e.evaluate(n) == Allowed
+		}
+	}
+	return newSatisfiesEvaluator(a).satisfies(n)
+}
+
+// isAllowed checks if a license, license ref, or special value is compatible with the allowed
+// list.
+func (a *AllowList) isAllowed(n *node) bool {
+	return a.match(n) != ""
+}
+
+// match returns the entry in the allowed list that is compatible with a license, license ref, or
+// special value, or "" if there is none.  The index is searched for an exactly equal license first,
+// and only licenses that are not found are checked against the ranges of every allowed license and
+// then the patterns.  License refs and special values are only compatible with an equal license ref
+// or special value, or a pattern, so their ranges are never checked.
+func (a *AllowList) match(n *node) string {
+	for _, allowed := range a.exact[strings.ToLower(*n.reconstructedLicenseString())] {
+		nodes := &nodePair{firstNode: n, secondNode: allowed}
+		if allowed.isLicense() && n.isLicense() && nodes.exceptionsAreCompatible() || nodes.licenseRefsAreCompatible() ||
+			allowed.isSpecialValue() && n.isSpecialValue() {
+			return a.entries[allowed]
+		}
+	}
+	if n.isLicense() {
+		for _, allowed := range a.allowed {
+			nodes := &nodePair{firstNode: n, secondNode: allowed}
+			if nodes.licensesAreCompatible() {
+				return a.entries[allowed]
+			}
+		}
+	}
+	for _, pattern := range a.patterns {
+		if pattern.matches(n) {
+			return pattern.entry
+		}
+	}
+	return ""
+}
+
+// matchBranch returns the entry in the allowed list that is compatible with each license in a
+// branch of ANDed licenses, or "" for a license that is not allowed.  Licenses that are not allowed
+// on their own can be matched by a license in a bundle, when every license in the bundle is
+// compatible with a license in the branch.
+func (a *AllowList) matchBranch(branch []*node) []string {
+	matches := make([]string, len(branch))
+	missing := false
+	for i, license := range branch {
+		matches[i] = a.match(license)
+		missing = missing || matches[i] == ""
+	}
+	if !missing {
+		return matches
+	}
+
+	for _, bundle := range a.bundles {
+		if !bundleInBranch(bundle, branch) {
+			continue
+		}
+		for i, license := range branch {
+			if matches[i] != "" {
+				continue
+			}
+			for _, allowed := range bundle {
+				if isCompatible([]*node{license}, []*node{allowed}) {
+					matches[i] = a.entries[allowed]
+					break
+				}
+			}
+		}
+	}
+	return matches
+}
+
+// bundleInBranch checks if every license in a bundle is compatible with a license in a branch.
+func bundleInBranch(bundle, branch []*node) bool {
+	for _, allowed := range bundle {
+		found := false
+		for _, license := range branch {
+			if isCompatible([]*node{license}, []*node{allowed}) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
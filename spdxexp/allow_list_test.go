@@ -0,0 +1,136 @@
+package spdxexp
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileAllowList(t *testing.T) {
+	tests := []struct {
+		name        string
+		allowedList []string
+		allowed     []string
+		bundles     [][]string
+		err         error
+	}{
+		{"licenses are normalized, sorted and deduped", []string{"mit", "Apache-2.0", "MIT", "GPL-2.0+"},
+			[]string{"Apache-2.0", "GPL-2.0-or-later", "MIT"}, nil, nil},
+		{"refs, exceptions and special values", []string{"LicenseRef-X", "GPL-2.0 WITH Classpath-exception-2.0", "NONE"},
+			[]string{"GPL-2.0 WITH Classpath-exception-2.0", "LicenseRef-X", "NONE"}, nil, nil},
+		{"ORed licenses are allowed", []string{"GPL-2.0-only WITH Classpath-exception-2.0 OR CDDL-1.1", "MIT"},
+			[]string{"CDDL-1.1", "GPL-2.0-only WITH Classpath-exception-2.0", "MIT"}, nil, nil},
+		{"ANDed licenses are bundles", []string{"MIT AND Apache-2.0", "ISC OR (BSD-2-Clause AND 0BSD AND MIT)"},
+			[]string{"ISC"}, [][]string{{"Apache-2.0", "MIT"}, {"0BSD", "BSD-2-Clause", "MIT"}}, nil},
+		{"empty list", []string{}, nil, nil, errors.New("allowedList requires at least one element, but is empty")},
+		{"invalid license", []string{"MIT", "Apache2"}, nil, nil,
+			errors.New("invalid allowedList entry \"Apache2\" at index 1: unknown license 'Apache2' at offset 0")},
+		{"invalid expression", []string{"MIT AND"}, nil, nil,
+			errors.New("invalid allowedList entry \"MIT AND\" at index 0: expected expression following AND, but found none")},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			allowList, err := CompileAllowList(test.allowedList)
+			requireEqualError(t, test.err, err)
+			if test.err != nil {
+				assert.Nil(t, allowList)
+				return
+			}
+			var allowed []string
+			for _, n := range allowList.allowed {
+				allowed = append(allowed, *n.reconstructedLicenseString())
+			}
+			assert.Equal(t, test.allowed, allowed)
+
+			var bundles [][]string
+			for _, bundle := range allowList.bundles {
+				var licenses []string
+				for _, n := range bundle {
+					licenses = append(licenses, *n.reconstructedLicenseString())
+				}
+				bundles = append(bundles, licenses)
+			}
+			assert.Equal(t, test.bundles, bundles)
+		})
+	}
+}
+
+func TestAllowListError(t *testing.T) {
+	_, err := CompileAllowList([]string{"MIT", "MIT OR"})
+	var allowListErr *AllowListError
+	require.True(t, errors.As(err, &allowListErr))
+	assert.Equal(t, 1, allowListErr.Index)
+	assert.Equal(t, "MIT OR", allowListErr.Entry)
+
+	// expressions with too many branches are invalid
+	_, err = CompileAllowList([]string{manyANDedORs(20)})
+	var limitErr *NormalFormLimitError
+	assert.True(t, errors.As(err, &limitErr))
+
+	// parse errors are wrapped
+	_, err = CompileAllowList([]string{"MIT AND"})
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, MissingOperand, parseErr.Kind)
+}
+
+func TestAllowListSatisfies(t *testing.T) {
+	allowList, err := CompileAllowList([]string{"MIT", "Apache-2.0+", "GPL-2.0-only WITH Classpath-exception-2.0", "LicenseRef-X", "NOASSERTION"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		testExpression string
+		options        SatisfiesOptions
+		satisfied      bool
+		err            error
+	}{
+		{"license", " mit ", SatisfiesOptions{}, true, nil},
+		{"license not allowed", "ISC", SatisfiesOptions{}, false, nil},
+		{"license in range", "Apache-2.0", SatisfiesOptions{}, true, nil},
+		{"license not in range", "Apache-1.0", SatisfiesOptions{}, false, nil},
+		{"exception", "GPL-2.0 WITH Classpath-exception-2.0", SatisfiesOptions{}, true, nil},
+		{"exception not allowed", "GPL-2.0", SatisfiesOptions{}, false, nil},
+		{"license ref", "LicenseRef-X", SatisfiesOptions{}, true, nil},
+		{"expression", "(ISC OR MIT) AND LicenseRef-X", SatisfiesOptions{}, true, nil},
+		{"expression not allowed", "ISC OR MIT AND LicenseRef-Y", SatisfiesOptions{}, false, nil},
+		{"special value rejected", "NOASSERTION", SatisfiesOptions{}, false,
+			errors.New("special value is not allowed: NOASSERTION")},
+		{"special value allowed", "noassertion", SatisfiesOptions{SpecialValues: AllowSpecialValues}, true, nil},
+		{"special value not in list", "NONE", SatisfiesOptions{SpecialValues: AllowSpecialValues}, false, nil},
+		{"invalid expression", "MIT AND", SatisfiesOptions{}, false, errors.New("expected expression following AND, but found none")},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			satisfied, err := allowList.SatisfiesWithOptions(test.testExpression, test.options)
+			requireEqualError(t, test.err, err)
+			assert.Equal(t, test.satisfied, satisfied)
+		})
+	}
+}
+
+func TestAllowListSatisfiesConcurrently(t *testing.T) {
+	allowList, err := CompileAllowList([]string{"MIT", "Apache-2.0+", "LicenseRef-X"})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				satisfied, err := allowList.Satisfies("(ISC OR MIT) AND (Apache-2.0 OR LicenseRef-X)")
+				assert.NoError(t, err)
+				assert.True(t, satisfied)
+			}
+		}()
+	}
+	wg.Wait()
+}
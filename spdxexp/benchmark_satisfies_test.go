@@ -24,6 +24,15 @@ var satisfiesBenchmarkScenarios = []satisfiesBenchmarkScenario{
 	{"Apache-1.0+--plus-range", "Apache-1.0+"},
 	{"LicenseRef-scancode-adobe-postscript", "LicenseRef-scancode-adobe-postscript"},
 	{"DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2", "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2"},
+	{"12 ANDed ORs--large", manyANDedORs(12)},
+	{"depth 64--deeply-nested", deeplyNested(64)},
+}
+
+// Scenarios that are slow when the test expression is expanded, used to compare Satisfies with
+// the expanding evaluation that it replaced.
+var satisfiesExpandedBenchmarkScenarios = []satisfiesBenchmarkScenario{
+	{"12 ANDed ORs--large", manyANDedORs(12)},
+	{"depth 64--deeply-nested", deeplyNested(64)},
 }
 
 func BenchmarkSatisfies(b *testing.B) {
@@ -35,6 +44,19 @@ func BenchmarkSatisfies(b *testing.B) {
 	}
 }
 
+func BenchmarkSatisfiesExpanded(b *testing.B) {
+	for _, scenario := range satisfiesExpandedBenchmarkScenarios {
+		scenario := scenario
+		b.Run(scenario.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				satisfiesExpanded(scenario.testExpression, allowList())
+			}
+		})
+	}
+}
+
 func benchmarkSatisfiesScenario(b *testing.B, expression string) {
 	b.ReportAllocs()
 	b.ResetTimer()
@@ -68,6 +90,84 @@ func computeSatisfiesBenchmarkTableRows(repeats int) []benchmarkTableRow {
 	return rows
 }
 
+// Return an expression nested to the given depth that alternates AND and OR, and is not
+// satisfied by allowList, so that every alternative is evaluated.
+//
+// Example:
+//
+//	deeplyNested(2) is "MIT AND (LicenseRef-0 OR MIT AND (LicenseRef-1 OR LicenseRef-end))"
+func deeplyNested(depth int) string {
+	if depth == 0 {
+		return "LicenseRef-end"
+	}
+	return fmt.Sprintf("MIT AND (LicenseRef-%d OR %s)", depth, deeplyNested(depth-1))
+}
+
+func computeAllowListSatisfiesBenchmarkTableRows(repeats int) []benchmarkTableRow {
+	rows := make([]benchmarkTableRow, 0, len(satisfiesBenchmarkScenarios))
+
+	// the allowed list is compiled once and reused, as a caller checking many expressions would
+	allowed, err := CompileAllowList(allowList())
+	if err != nil {
+		panic(fmt.Sprintf("CompileAllowList(allowList) error: %v", err))
+	}
+
+	for _, scenario := range satisfiesBenchmarkScenarios {
+		scenario := scenario
+		avg := runBenchmarkNsAvg(repeats, func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, err := allowed.Satisfies(scenario.testExpression)
+				if err != nil {
+					panic(fmt.Sprintf("AllowList.Satisfies scenario %q error: %v", scenario.name, err))
+				}
+			}
+		})
+		rows = append(rows, benchmarkTableRow{label: scenario.name, nsOpAvg: avg})
+	}
+
+	return rows
+}
+
+func computeSatisfiesExpandedBenchmarkTableRows(repeats int) []benchmarkTableRow {
+	rows := make([]benchmarkTableRow, 0, len(satisfiesExpandedBenchmarkScenarios))
+
+	for _, scenario := range satisfiesExpandedBenchmarkScenarios {
+		scenario := scenario
+		avg := runBenchmarkNsAvg(repeats, func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				satisfiesExpanded(scenario.testExpression, allowList())
+			}
+		})
+		rows = append(rows, benchmarkTableRow{label: scenario.name, nsOpAvg: avg})
+	}
+
+	return rows
+}
+
+// satisfiesExpanded evaluates the test expression by expanding it, which is how Satisfies
+// evaluated complex expressions before satisfiesEvaluator.
+func satisfiesExpanded(expression string, allowedList []string) bool {
+	expressionNode, err := parse(expression)
+	if err != nil {
+		panic(fmt.Sprintf("parse(%q) error: %v", expression, err))
+	}
+	allowed, err := CompileAllowList(allowedList)
+	if err != nil {
+		panic(fmt.Sprintf("CompileAllowList(allowedList) error: %v", err))
+	}
+
+	for _, part := range expressionNode.expand(true) {
+		if isCompatible(part, allowed.allowed) {
+			return true
+		}
+	}
+	return false
+}
+
 func allowList() []string {
 	// common list of permissive licenses to simulate a realistic use case for Satisfies
 	return []string{
@@ -55,6 +55,12 @@ func TestMain(m *testing.M) {
 
 		satisfiesRows := withScaleColumn(computeSatisfiesBenchmarkTableRows(benchmarkRepeatsForSummary), benchmarkScaleBaselineSatisfiesNsOp)
 		printBenchmarkTable(os.Stdout, "Benchmark Satisfies", satisfiesRows, benchmarkScaleBaselineSatisfiesNsOp)
+
+		allowListRows := withScaleColumn(computeAllowListSatisfiesBenchmarkTableRows(benchmarkRepeatsForSummary), benchmarkScaleBaselineSatisfiesNsOp)
+		printBenchmarkTable(os.Stdout, "Benchmark AllowList.Satisfies", allowListRows, benchmarkScaleBaselineSatisfiesNsOp)
+
+		satisfiesExpandedRows := withScaleColumn(computeSatisfiesExpandedBenchmarkTableRows(benchmarkRepeatsForSummary), benchmarkScaleBaselineSatisfiesNsOp)
+		printBenchmarkTable(os.Stdout, "Benchmark Satisfies (expanded)", satisfiesExpandedRows, benchmarkScaleBaselineSatisfiesNsOp)
 	}
 
 	os.Exit(code)
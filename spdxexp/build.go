@@ -0,0 +1,170 @@
+package spdxexp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// License returns an expression for a license identifier.  When plus is true, the license also
+// allows later versions (e.g. License("Apache-1.0", true) is "Apache-1.0+", and
+// License("GPL-2.0", true) and License("GPL-2.0-only", true) are "GPL-2.0-or-later").  The id is
+// normalized to the case used in the SPDX license list.
+// Returns an error if the id is not an active or deprecated SPDX license, or if plus is true for
+// an -only license that has no -or-later license.
+func License(id string, plus bool) (*Expression, error) {
+	if !isID(id) {
+		return nil, fmt.Errorf("invalid license id '%s'", id)
+	}
+	source := id
+	if plus {
+		source += "+"
+		if base, ok := cutSuffixFold(id, "-only"); ok {
+			// an -only license does not allow later versions, so use its -or-later license instead
+			if isLicense, _ := activeLicense(id); isLicense {
+				hasOrLater, orLater := activeLicense(base + "-or-later")
+				if !hasOrLater {
+					return nil, fmt.Errorf("'%s' does not allow later versions", id)
+				}
+				source = orLater
+			}
+		}
+	}
+	tokens, err := scan(source, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &tokenStream{tokens: tokens, index: 0, err: nil, end: len(source)}
+	n := t.parseLicense()
+	if t.err != nil {
+		return nil, t.err
+	}
+	if n == nil || t.hasMore() {
+		return nil, fmt.Errorf("'%s' is not a license", id)
+	}
+	return newExpression(n, nil), nil
+}
+
+// cutSuffixFold returns s without the suffix and true if s ends with the suffix, ignoring case;
+// otherwise, s and false.
+func cutSuffixFold(s, suffix string) (string, bool) {
+	if len(s) >= len(suffix) && strings.EqualFold(s[len(s)-len(suffix):], suffix) {
+		return s[:len(s)-len(suffix)], true
+	}
+	return s, false
+}
+
+// Ref returns an expression for a license reference with an optional document reference.  The
+// ids can be given with or without their prefixes (e.g. Ref("spdx-tool-1.2", "MIT-Style-2") and
+// Ref("DocumentRef-spdx-tool-1.2", "LicenseRef-MIT-Style-2") are both
+// "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2").  Use "" for documentRef to omit the
+// document reference.
+// Returns an error if either id contains characters other than letters, digits, "-", and ".".
+func Ref(documentRef, licenseRef string) (*Expression, error) {
+	documentRef = strings.TrimPrefix(documentRef, "DocumentRef-")
+	licenseRef = strings.TrimPrefix(licenseRef, "LicenseRef-")
+	if !isID(licenseRef) {
+		return nil, fmt.Errorf("invalid LicenseRef id '%s'", licenseRef)
+	}
+	if documentRef != "" && !isID(documentRef) {
+		return nil, fmt.Errorf("invalid DocumentRef id '%s'", documentRef)
+	}
+
+	ref := referenceNodePartial{
+		hasDocumentRef: documentRef != "",
+		documentRef:    documentRef,
+		licenseRef:     licenseRef,
+	}
+	return newExpression(&node{role: licenseRefNode, ref: &ref}, nil), nil
+}
+
+// With returns an expression for a license or license reference with an exception.  The
+// exception is an SPDX exception identifier, which is normalized to the case used in the SPDX
+// exception list, or a custom addition (e.g. "AdditionRef-Custom",
+// "DocumentRef-spdx-tool-1.2:AdditionRef-Custom").
+// Returns an error if license is not a License or LicenseRef expression or if the exception is not
+// an SPDX exception or custom addition.
+func With(license *Expression, exception string) (*Expression, error) {
+	if license == nil {
+		return nil, errors.New("WITH requires a license, but found none")
+	}
+	if kind := license.Kind(); kind != LicenseKind && kind != LicenseRefKind {
+		return nil, fmt.Errorf("WITH requires a license or license reference, but found %s", kind)
+	}
+
+	tokens, err := scan(exception, nil)
+	if err != nil {
+		return nil, err
+	}
+	t := &tokenStream{tokens: tokens, index: 0, err: nil, end: len(exception)}
+	addition, ok := t.readAddition()
+	if !ok || t.hasMore() {
+		return nil, fmt.Errorf("'%s' is not an exception", exception)
+	}
+
+	n := *license.node
+	if n.isLicenseRef() {
+		ref := *n.ref
+		ref.hasException = true
+		ref.exception = addition
+		n.ref = &ref
+	} else {
+		lic := *n.lic
+		lic.hasException = true
+		lic.exception = addition
+		n.lic = &lic
+	}
+	return newExpression(&n, nil), nil
+}
+
+// And returns an expression that requires all of the expressions (e.g. And(mit, apache) is
+// "MIT AND Apache-2.0").  A single expression is returned unchanged.
+// Returns an error if there are no expressions, an expression is nil, or NONE or NOASSERTION is
+// combined with other expressions.
+func And(expressions ...*Expression) (*Expression, error) {
+	return joinExpressions("and", expressions)
+}
+
+// Or returns an expression that allows any one of the expressions (e.g. Or(mit, apache) is
+// "MIT OR Apache-2.0").  A single expression is returned unchanged.
+// Returns an error if there are no expressions, an expression is nil, or NONE or NOASSERTION is
+// combined with other expressions.
+func Or(expressions ...*Expression) (*Expression, error) {
+	return joinExpressions("or", expressions)
+}
+
+// Join the expressions with the conjunction.  The tree has the same shape as a parsed expression,
+// where "A AND B AND C" is "A AND (B AND C)".
+func joinExpressions(conjunction string, expressions []*Expression) (*Expression, error) {
+	operator := strings.ToUpper(conjunction)
+	if len(expressions) == 0 {
+		return nil, fmt.Errorf("%s requires at least one expression, but found none", operator)
+	}
+
+	var n *node
+	for i := len(expressions) - 1; i >= 0; i-- {
+		expression := expressions[i]
+		if expression == nil {
+			return nil, fmt.Errorf("%s requires non-nil expressions, but expression %d is nil", operator, i)
+		}
+		if len(expressions) > 1 && expression.node.isSpecialValue() {
+			return nil, fmt.Errorf("%s must be the entire expression", *expression.node.specialValue())
+		}
+		n = joinNodes(expression.node, conjunction, n)
+	}
+	return newExpression(n, nil), nil
+}
+
+// Return true if the string is a non-empty id made of letters, digits, "-", and ".".
+func isID(id string) bool {
+	if id == "" {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if !isIDChar(id[i]) {
+			return false
+		}
+	}
+	return true
+}
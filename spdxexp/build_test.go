@@ -0,0 +1,171 @@
+package spdxexp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLicense(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		plus    bool
+		kind    Kind
+		hasPlus bool
+		str     string
+		err     error
+	}{
+		{"active license", "mit", false, LicenseKind, false, "MIT", nil},
+		{"license with plus", "Apache-1.0", true, LicenseKind, true, "Apache-1.0+", nil},
+		{"-or-later license", "GPL-2.0-or-later", false, LicenseKind, true, "GPL-2.0-or-later", nil},
+		{"deprecated license with plus uses -or-later", "GPL-2.0", true, LicenseKind, true, "GPL-2.0-or-later", nil},
+		{"-only license with plus uses -or-later", "gpl-2.0-only", true, LicenseKind, true, "GPL-2.0-or-later", nil},
+		{"-or-later license with plus", "GPL-2.0-or-later", true, LicenseKind, true, "GPL-2.0-or-later", nil},
+		{"deprecated license", "eCos-2.0", false, LicenseKind, false, "eCos-2.0", nil},
+		{"unknown license", "Apache2", false, 0, false, "", errors.New("unknown license 'Apache2' at offset 0")},
+		{"exception is not a license", "Bison-exception-2.2", false, 0, false, "", errors.New("'Bison-exception-2.2' is not a license")},
+		{"special value is not a license", "NONE", false, 0, false, "", errors.New("'NONE' is not a license")},
+		{"expression is not an id", "MIT OR ISC", false, 0, false, "", errors.New("invalid license id 'MIT OR ISC'")},
+		{"empty id", "", false, 0, false, "", errors.New("invalid license id ''")},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			expression, err := License(test.id, test.plus)
+			requireEqualError(t, test.err, err)
+			if test.err != nil {
+				assert.Nil(t, expression)
+				return
+			}
+			assert.Equal(t, test.kind, expression.Kind())
+			assert.Equal(t, test.hasPlus, expression.HasPlus())
+			assert.Equal(t, test.str, expression.String())
+			_, ok := expression.Span()
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestRef(t *testing.T) {
+	tests := []struct {
+		name        string
+		documentRef string
+		licenseRef  string
+		str         string
+		err         error
+	}{
+		{"license ref", "", "MIT-Style-2", "LicenseRef-MIT-Style-2", nil},
+		{"license ref with document ref", "spdx-tool-1.2", "MIT-Style-2", "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2", nil},
+		{"ids with prefixes", "DocumentRef-spdx-tool-1.2", "LicenseRef-MIT-Style-2", "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2", nil},
+		{"invalid license ref", "", "MIT Style", "", errors.New("invalid LicenseRef id 'MIT Style'")},
+		{"missing license ref", "spdx-tool-1.2", "", "", errors.New("invalid LicenseRef id ''")},
+		{"invalid document ref", "spdx:tool", "MIT-Style-2", "", errors.New("invalid DocumentRef id 'spdx:tool'")},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			expression, err := Ref(test.documentRef, test.licenseRef)
+			requireEqualError(t, test.err, err)
+			if test.err != nil {
+				assert.Nil(t, expression)
+				return
+			}
+			assert.Equal(t, LicenseRefKind, expression.Kind())
+			assert.Equal(t, test.str, expression.String())
+		})
+	}
+}
+
+func TestWith(t *testing.T) {
+	gpl, err := License("GPL-2.0", true)
+	require.NoError(t, err)
+	ref, err := Ref("", "X")
+	require.NoError(t, err)
+	and, err := And(gpl, ref)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		license   *Expression
+		exception string
+		str       string
+		err       error
+	}{
+		{"license with exception", gpl, "bison-exception-2.2", "GPL-2.0-or-later WITH Bison-exception-2.2", nil},
+		{"license with addition ref", gpl, "DocumentRef-spdx-tool-1.2:AdditionRef-Custom", "GPL-2.0-or-later WITH DocumentRef-spdx-tool-1.2:AdditionRef-Custom", nil},
+		{"license ref with addition ref", ref, "AdditionRef-Custom", "LicenseRef-X WITH AdditionRef-Custom", nil},
+		{"unknown exception", gpl, "Foo-exception", "", errors.New("unknown license 'Foo-exception' at offset 0")},
+		{"license is not an exception", gpl, "MIT", "", errors.New("'MIT' is not an exception")},
+		{"expression is not an exception", gpl, "Bison-exception-2.2 OR MIT", "", errors.New("'Bison-exception-2.2 OR MIT' is not an exception")},
+		{"nil license", nil, "Bison-exception-2.2", "", errors.New("WITH requires a license, but found none")},
+		{"expression is not a license", and, "Bison-exception-2.2", "", errors.New("WITH requires a license or license reference, but found And")},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			expression, err := With(test.license, test.exception)
+			requireEqualError(t, test.err, err)
+			if test.err != nil {
+				assert.Nil(t, expression)
+				return
+			}
+			assert.Equal(t, WithKind, expression.Kind())
+			assert.Equal(t, test.str, expression.String())
+		})
+	}
+
+	// the license is not changed
+	assert.Equal(t, "GPL-2.0-or-later", gpl.String())
+	assert.Equal(t, "LicenseRef-X", ref.String())
+}
+
+func TestAndOr(t *testing.T) {
+	license := func(id string) *Expression {
+		expression, err := License(id, false)
+		require.NoError(t, err)
+		return expression
+	}
+	mit, apache, isc := license("MIT"), license("Apache-2.0"), license("ISC")
+	none, err := Parse("NONE")
+	require.NoError(t, err)
+
+	and, err := And(mit, apache, isc)
+	require.NoError(t, err)
+	assert.Equal(t, "MIT AND Apache-2.0 AND ISC", and.String())
+	assert.Equal(t, AndKind, and.Kind())
+	assert.Equal(t, "MIT", and.Left().String())
+	assert.Equal(t, "Apache-2.0 AND ISC", and.Right().String())
+
+	or, err := Or(mit, and)
+	require.NoError(t, err)
+	assert.Equal(t, "MIT OR MIT AND Apache-2.0 AND ISC", or.String())
+
+	// parentheses are added where required by precedence
+	orFirst, err := Or(mit, apache)
+	require.NoError(t, err)
+	and, err = And(orFirst, isc)
+	require.NoError(t, err)
+	assert.Equal(t, "(MIT OR Apache-2.0) AND ISC", and.String())
+
+	// the built expression has the same tree as the parsed expression
+	parsed, err := Parse(and.String())
+	require.NoError(t, err)
+	assert.Equal(t, parsed.node, and.node)
+
+	single, err := Or(none)
+	require.NoError(t, err)
+	assert.Equal(t, "NONE", single.String())
+
+	_, err = And()
+	assert.EqualError(t, err, "AND requires at least one expression, but found none")
+	_, err = Or(mit, nil)
+	assert.EqualError(t, err, "OR requires non-nil expressions, but expression 1 is nil")
+	_, err = And(mit, none)
+	assert.EqualError(t, err, "NONE must be the entire expression")
+}
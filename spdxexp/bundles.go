@@ -0,0 +1,324 @@
+package spdxexp
+
+import "encoding/binary"
+
+// listVerdicts are the verdicts of the licenses in the denied, review, and allowed lists of a
+// bundleEvaluator, in order of precedence.
+var listVerdicts = [3]Verdict{Denied, NeedsReview, Allowed}
+
+// bundleEvaluator evaluates an expression against lists of licenses with bundles by walking the
+// expression tree, like satisfiesEvaluator, instead of expanding it into branches.  Whether a
+// license is matched by a bundle depends on the other licenses in its ORed branch, so each node is
+// evaluated to the distinct states of its branches instead of a single result.  A state only
+// records the bundle licenses in the branch and the kinds of licenses whose verdict depends on
+// them, so the number of states of a node is limited by the bundles in the lists, not by the size
+// of the expression.
+type bundleEvaluator struct {
+	// lists are the denied, review, and allowed lists, in order of precedence.  A nil list is empty.
+	lists [3]*AllowList
+	// unlisted is the verdict of a license that is not in any list.
+	unlisted Verdict
+	// bundles of the lists whose licenses are all compatible with licenses in the expression, since
+	// other bundles cannot be complete in any branch.
+	bundles []evaluatedBundle
+	// members is the number of licenses in bundles.
+	members int
+	// leaves has each distinct license, license ref, and special value in the expression.
+	leaves map[leafKey]*bundleLeaf
+	// dependent has one leaf for each distinct way that a verdict depends on bundles, since leaves
+	// that depend on the same bundles in the same way always have the same verdict.
+	dependent []*bundleLeaf
+}
+
+// evaluatedBundle is a bundle of a list that can be complete in a branch of the expression.
+type evaluatedBundle struct {
+	// list is the index of the list with the bundle.
+	list int
+	// licenses in the bundle
+	licenses []*node
+	// first is the position of the first license of the bundle in a bundleState's present licenses.
+	first int
+}
+
+// bundleLeaf is a license in the expression and the bundles that it is compatible with.
+type bundleLeaf struct {
+	// listed is whether each list matches the license on its own.
+	listed [3]bool
+	// present has the positions of the bundle licenses that the license is compatible with.
+	present bitset
+	// bundles has the bundles of each list with a license that the license is compatible with.
+	bundles [3]bitset
+	// dependent is the index of the leaf in bundleEvaluator.dependent, or -1 if its verdict does
+	// not depend on bundles.
+	dependent int
+	// verdict of the license when it does not depend on bundles.
+	verdict Verdict
+}
+
+// bundleState is the state of a branch of an expression.
+type bundleState struct {
+	// present has the positions of the bundle licenses in the branch.
+	present bitset
+	// dependent has the indexes of the leaves that depend on bundles in the branch.
+	dependent bitset
+	// verdict is the worst verdict of the licenses in the branch that do not depend on bundles.
+	verdict Verdict
+}
+
+// newBundleEvaluator indexes the licenses of an expression and the bundles of the denied, review,
+// and allowed lists that can be complete in a branch of it.
+func newBundleEvaluator(n *node, unlisted Verdict, denied, review, allowed *AllowList) *bundleEvaluator {
+	e := &bundleEvaluator{lists: [3]*AllowList{denied, review, allowed}, unlisted: unlisted, leaves: map[leafKey]*bundleLeaf{}}
+	licenses := map[leafKey]*node{}
+	collectLeafKeys(n, licenses)
+	nodes := make([]*node, 0, len(licenses))
+	for _, license := range licenses {
+		nodes = append(nodes, license)
+	}
+
+	for i, list := range e.lists {
+		if list == nil {
+			continue
+		}
+		for _, bundle := range list.bundles {
+			if bundleInBranch(bundle, nodes) {
+				e.bundles = append(e.bundles, evaluatedBundle{list: i, licenses: bundle, first: e.members})
+				e.members += len(bundle)
+			}
+		}
+	}
+
+	dependent := map[string]int{}
+	for key, license := range licenses {
+		leaf := e.newLeaf(license)
+		if leaf.dependsOnBundles() {
+			signature := leaf.signature()
+			index, ok := dependent[signature]
+			if !ok {
+				index = len(e.dependent)
+				dependent[signature] = index
+				e.dependent = append(e.dependent, leaf)
+			}
+			leaf.dependent = index
+		} else {
+			leaf.verdict = e.verdict(leaf, nil)
+		}
+		e.leaves[key] = leaf
+	}
+	return e
+}
+
+// newLeaf finds the lists that match a license on its own and the bundles that it is compatible with.
+func (e *bundleEvaluator) newLeaf(license *node) *bundleLeaf {
+	leaf := &bundleLeaf{present: newBitset(e.members), dependent: -1}
+	for i, list := range e.lists {
+		leaf.listed[i] = list != nil && list.isAllowed(license)
+		leaf.bundles[i] = newBitset(len(e.bundles))
+	}
+	for j, bundle := range e.bundles {
+		for k, allowed := range bundle.licenses {
+			if isCompatible([]*node{license}, []*node{allowed}) {
+				leaf.present.set(bundle.first + k)
+				leaf.bundles[bundle.list].set(j)
+			}
+		}
+	}
+	return leaf
+}
+
+// dependsOnBundles checks if the verdict of a leaf can be changed by a bundle, which is when a
+// list that does not match it on its own has a bundle that it is compatible with, before any list
+// with higher precedence matches it.
+func (l *bundleLeaf) dependsOnBundles() bool {
+	for i := range l.listed {
+		if l.listed[i] {
+			return false
+		}
+		if !l.bundles[i].isEmpty() {
+			return true
+		}
+	}
+	return false
+}
+
+// signature identifies the lists and bundles that determine the verdict of a leaf.
+func (l *bundleLeaf) signature() string {
+	var key []byte
+	for i := range l.listed {
+		if l.listed[i] {
+			key = append(key, 1)
+		} else {
+			key = append(key, 0)
+		}
+		key = l.bundles[i].appendKey(key)
+	}
+	return string(key)
+}
+
+// evaluate returns the best verdict of the branches of an expression, where the verdict of a
+// branch is the worst verdict of its licenses.
+func (e *bundleEvaluator) evaluate(n *node) Verdict {
+	verdict := Denied
+	for _, state := range e.states(n) {
+		complete := newBitset(len(e.bundles))
+		for j, bundle := range e.bundles {
+			if state.present.hasRange(bundle.first, len(bundle.licenses)) {
+				complete.set(j)
+			}
+		}
+		v := state.verdict
+		for i, leaf := range e.dependent {
+			if state.dependent.has(i) {
+				v = max(v, e.verdict(leaf, complete))
+			}
+		}
+		verdict = min(verdict, v)
+	}
+	return verdict
+}
+
+// verdict returns the verdict of a leaf in a branch where the complete bundles are in complete,
+// which is the verdict of the first list that matches it on its own or with a complete bundle.
+func (e *bundleEvaluator) verdict(leaf *bundleLeaf, complete bitset) Verdict {
+	for i := range leaf.listed {
+		if leaf.listed[i] || leaf.bundles[i].intersects(complete) {
+			return listVerdicts[i]
+		}
+	}
+	return e.unlisted
+}
+
+// states returns the distinct states of the branches of a node.  The branches of an OR expression
+// are the branches of either side, and the branches of an AND expression combine a branch of each
+// side.  Branches with a denied license are dropped, since their verdict cannot be better.
+func (e *bundleEvaluator) states(n *node) []bundleState {
+	if n.isExpression() {
+		left := e.states(n.left())
+		if n.isOrExpression() {
+			return e.merge(append(left, e.states(n.right())...))
+		}
+		if len(left) == 0 {
+			return nil
+		}
+		right := e.states(n.right())
+		combined := make([]bundleState, 0, len(left)*len(right))
+		for _, l := range left {
+			for _, r := range right {
+				combined = append(combined, bundleState{
+					present:   l.present.union(r.present),
+					dependent: l.dependent.union(r.dependent),
+					verdict:   max(l.verdict, r.verdict),
+				})
+			}
+		}
+		return e.merge(combined)
+	}
+
+	leaf := e.leaves[newLeafKey(n)]
+	state := bundleState{present: leaf.present, dependent: newBitset(len(e.dependent)), verdict: leaf.verdict}
+	if leaf.dependent >= 0 {
+		state.dependent.set(leaf.dependent)
+	}
+	return e.merge([]bundleState{state})
+}
+
+// merge drops states with a denied license, and merges states with the same licenses, keeping
+// the best verdict.
+func (e *bundleEvaluator) merge(states []bundleState) []bundleState {
+	merged := states[:0]
+	var index map[string]int
+	for _, state := range states {
+		if state.verdict == Denied {
+			continue
+		}
+		if len(states) == 1 {
+			merged = append(merged, state)
+			continue
+		}
+		if index == nil {
+			index = make(map[string]int, len(states))
+		}
+		key := string(state.dependent.appendKey(state.present.appendKey(nil)))
+		if i, ok := index[key]; ok {
+			merged[i].verdict = min(merged[i].verdict, state.verdict)
+			continue
+		}
+		index[key] = len(merged)
+		merged = append(merged, state)
+	}
+	return merged
+}
+
+// collectLeafKeys adds each distinct license, license ref, and special value in an expression to
+// leaves.
+func collectLeafKeys(n *node, leaves map[leafKey]*node) {
+	if n.isExpression() {
+		collectLeafKeys(n.left(), leaves)
+		collectLeafKeys(n.right(), leaves)
+		return
+	}
+	key := newLeafKey(n)
+	if _, ok := leaves[key]; !ok {
+		leaves[key] = n
+	}
+}
+
+// bitset is a set of small non-negative integers.
+type bitset []uint64
+
+func newBitset(size int) bitset {
+	return make(bitset, (size+63)/64)
+}
+
+func (b bitset) set(i int) {
+	b[i/64] |= 1 << (i % 64)
+}
+
+func (b bitset) has(i int) bool {
+	return b[i/64]&(1<<(i%64)) != 0
+}
+
+// hasRange checks if the set has every integer from first to first+size-1.
+func (b bitset) hasRange(first, size int) bool {
+	for i := first; i < first+size; i++ {
+		if !b.has(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// union returns a new set with the integers of both sets, which have the same size.
+func (b bitset) union(other bitset) bitset {
+	u := make(bitset, len(b))
+	for i := range b {
+		u[i] = b[i] | other[i]
+	}
+	return u
+}
+
+func (b bitset) intersects(other bitset) bool {
+	for i := range b {
+		if i < len(other) && b[i]&other[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (b bitset) isEmpty() bool {
+	for _, word := range b {
+		if word != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// appendKey appends the set to a key for a map.
+func (b bitset) appendKey(key []byte) []byte {
+	for _, word := range b {
+		key = binary.LittleEndian.AppendUint64(key, word)
+	}
+	return key
+}
@@ -0,0 +1,127 @@
+package spdxexp
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrNoAllowedChoice is returned by Choose and ChooseWithCost when no branch of the expression
+// has only allowed licenses.
+var ErrNoAllowedChoice = errors.New("no choice of licenses in the expression is allowed")
+
+// Choose elects the licenses to use from a license expression, given a list of allowed licenses
+// ranked from most to least preferred.  Preferences have the same format as the allowed list of
+// Satisfies, so they can include ranges (e.g. "Apache-1.0+"), expressions, and patterns.
+//
+// Each ORed branch of the expression in disjunctive normal form (see ToDNF) is a choice of ANDed
+// licenses.  The cost of a license is the position of the first preference that allows it, and the
+// choice with the lowest total cost whose licenses are all allowed is returned, as an expression of
+// the licenses ANDed in the order of ToDNF.  Ties are broken by choosing fewer licenses and then
+// the expression that sorts first.
+//
+// Example:
+//
+//	Choose("MIT OR GPL-2.0-or-later OR Apache-2.0", []string{"Apache-2.0", "MIT"}) returns "Apache-2.0"
+//
+// The expression and preferences are always parsed with the license list embedded in the
+// spdxlicenses package.
+// Returns ErrNoAllowedChoice if no choice is allowed, an *AllowListError if a preference is
+// invalid, or error if the expression cannot be parsed or has more choices than DefaultMaxClauses.
+func Choose(expression string, preferences []string) (string, error) {
+	if len(preferences) == 0 {
+		return "", errors.New("preferences requires at least one element, but is empty")
+	}
+
+	// each preference is compiled separately, so that a license gets the position of the first
+	// preference that allows it, rather than the first match found in a combined list
+	ranked := make([]*AllowList, len(preferences))
+	for i, preference := range preferences {
+		allowed, err := compileLicenseList("preferences", []string{preference}, nil)
+		if err != nil {
+			var listErr *AllowListError
+			if errors.As(err, &listErr) {
+				listErr.Index = i
+			}
+			return "", err
+		}
+		ranked[i] = allowed
+	}
+
+	return choose(expression, func(branch []*node) (int, bool) {
+		costs := make([]int, len(branch))
+		for i := range costs {
+			costs[i] = -1
+		}
+		for rank, allowed := range ranked {
+			for i, match := range allowed.matchBranch(branch) {
+				if match != "" && costs[i] < 0 {
+					costs[i] = rank
+				}
+			}
+		}
+
+		total := 0
+		for _, cost := range costs {
+			if cost < 0 {
+				return 0, false
+			}
+			total += cost
+		}
+		return total, true
+	})
+}
+
+// ChooseWithCost elects the licenses to use from a license expression like Choose, using a cost
+// function instead of a list of preferences.  The cost function is called with each license,
+// normalized as by Parse, and returns the cost of the license, or false if it is not allowed.  The
+// expression is always parsed with the license list embedded in the spdxlicenses package.
+// Returns ErrNoAllowedChoice if no choice is allowed, or error if the expression cannot be parsed
+// or has more choices than DefaultMaxClauses.
+func ChooseWithCost(expression string, cost func(license string) (int, bool)) (string, error) {
+	return choose(expression, func(branch []*node) (int, bool) {
+		total := 0
+		for _, license := range branch {
+			c, ok := cost(*license.reconstructedLicenseString())
+			if !ok {
+				return 0, false
+			}
+			total += c
+		}
+		return total, true
+	})
+}
+
+// choose returns the branch of the expression with the lowest cost, as an expression of ANDed
+// licenses.  The cost function returns false for a branch that is not allowed.
+func choose(expression string, branchCost func(branch []*node) (int, bool)) (string, error) {
+	n, err := parse(strings.TrimSpace(expression))
+	if err != nil {
+		return "", err
+	}
+	branches, err := dnfBranches(n)
+	if err != nil {
+		return "", err
+	}
+
+	chosen, chosenCost, chosenLen := "", 0, 0
+	for _, branch := range branches {
+		cost, ok := branchCost(branch)
+		if !ok {
+			continue
+		}
+		licenses := make([]string, len(branch))
+		for i, license := range branch {
+			licenses[i] = *license.reconstructedLicenseString()
+		}
+		choice := strings.Join(licenses, " AND ")
+
+		if chosen == "" || cost < chosenCost || cost == chosenCost &&
+			(len(branch) < chosenLen || len(branch) == chosenLen && choice < chosen) {
+			chosen, chosenCost, chosenLen = choice, cost, len(branch)
+		}
+	}
+	if chosen == "" {
+		return "", ErrNoAllowedChoice
+	}
+	return chosen, nil
+}
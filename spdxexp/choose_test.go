@@ -0,0 +1,75 @@
+package spdxexp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChoose(t *testing.T) {
+	tests := []struct {
+		name        string
+		expression  string
+		preferences []string
+		chosen      string
+		err         error
+	}{
+		{"most preferred license", "MIT OR GPL-2.0-or-later OR Apache-2.0", []string{"Apache-2.0", "MIT"}, "Apache-2.0", nil},
+		{"preference order", "MIT OR GPL-2.0-or-later OR Apache-2.0", []string{"MIT", "Apache-2.0"}, "MIT", nil},
+		{"only allowed license", "MIT OR GPL-2.0-or-later", []string{"Apache-2.0", "GPL-2.0-or-later"}, "GPL-2.0-or-later", nil},
+		{"range", "GPL-3.0-only OR MIT", []string{"GPL-2.0+", "MIT"}, "GPL-3.0-only", nil},
+		{"first matching preference", "Apache-2.0 OR MIT", []string{"Apache-1.0+", "MIT", "Apache-2.0"}, "Apache-2.0", nil},
+		{"pattern", "MIT OR BSD-3-Clause", []string{"BSD-*", "MIT"}, "BSD-3-Clause", nil},
+		{"conjunction", "(MIT AND ISC) OR GPL-2.0-only", []string{"MIT", "ISC"}, "ISC AND MIT", nil},
+		{"lowest total cost", "(MIT AND ISC) OR (Apache-2.0 AND 0BSD)", []string{"MIT", "Apache-2.0", "0BSD", "ISC"}, "0BSD AND Apache-2.0", nil},
+		{"tie broken by fewer licenses", "(MIT AND ISC) OR Apache-2.0", []string{"MIT", "Apache-2.0", "ISC"}, "Apache-2.0", nil},
+		{"tie broken by sort order", "MIT OR ISC", []string{"*"}, "ISC", nil},
+		{"bundle", "MIT AND Apache-2.0", []string{"MIT AND Apache-2.0"}, "Apache-2.0 AND MIT", nil},
+		{"special value", "NOASSERTION", []string{"NOASSERTION"}, "NOASSERTION", nil},
+		{"nothing allowed", "MIT OR ISC", []string{"Apache-2.0"}, "", ErrNoAllowedChoice},
+		{"no preferences", "MIT", []string{}, "", errors.New("preferences requires at least one element, but is empty")},
+		{"invalid preference", "MIT", []string{"MIT", "Apache2"}, "",
+			errors.New("invalid preferences entry \"Apache2\" at index 1: unknown license 'Apache2' at offset 0")},
+		{"invalid expression", "MIT OR", []string{"MIT"}, "", errors.New("expected expression following OR, but found none")},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			chosen, err := Choose(test.expression, test.preferences)
+			requireEqualError(t, test.err, err)
+			assert.Equal(t, test.chosen, chosen)
+		})
+	}
+}
+
+func TestChooseWithCost(t *testing.T) {
+	costs := map[string]int{"MIT": 1, "ISC": 1, "BSD-2-Clause": 2, "Apache-2.0": 3, "GPL-2.0-or-later": 10}
+	cost := func(license string) (int, bool) {
+		c, ok := costs[license]
+		return c, ok
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		chosen     string
+		err        error
+	}{
+		{"lowest cost", "GPL-2.0-or-later OR Apache-2.0", "Apache-2.0", nil},
+		{"lowest total cost", "(MIT AND ISC) OR Apache-2.0", "ISC AND MIT", nil},
+		{"tie broken by fewer licenses", "(MIT AND ISC) OR BSD-2-Clause", "BSD-2-Clause", nil},
+		{"unknown license is not allowed", "BSD-3-Clause OR GPL-2.0+", "GPL-2.0-or-later", nil},
+		{"nothing allowed", "BSD-3-Clause", "", ErrNoAllowedChoice},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			chosen, err := ChooseWithCost(test.expression, cost)
+			requireEqualError(t, test.err, err)
+			assert.Equal(t, test.chosen, chosen)
+		})
+	}
+}
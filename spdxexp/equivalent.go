@@ -0,0 +1,97 @@
+package spdxexp
+
+// Equivalent determines if two license expressions mean the same thing, ignoring the order of
+// licenses and redundant parentheses (e.g. "MIT OR Apache-2.0" and "Apache-2.0 OR MIT"), and
+// treating equivalent identifiers as equal (e.g. "GPL-2.0+" and "GPL-2.0-or-later", "GPL-2.0"
+// and "GPL-2.0-only").  Expressions are equivalent when each implies the other.  Both expressions
+// are always parsed with the license list embedded in the spdxlicenses package.
+// Returns error if either expression cannot be parsed, or a *NormalFormLimitError if the
+// disjunctive normal form of either expression exceeds DefaultMaxClauses or DefaultMaxTerms.
+func Equivalent(a, b string) (bool, error) {
+	aBranches, bBranches, err := branchesPair(a, b)
+	if err != nil {
+		return false, err
+	}
+	return branchesImply(aBranches, bBranches) && branchesImply(bBranches, aBranches), nil
+}
+
+// Implies determines if license expression a implies license expression b, meaning that every
+// way of complying with a also complies with b.  In other words, the licenses permitted by b are
+// a superset of those permitted by a.
+//
+// Example:
+//
+//	"MIT" implies "MIT OR Apache-2.0", but not the reverse
+//	"MIT AND Apache-2.0" implies "MIT", but not the reverse
+//	"GPL-3.0-only" implies "GPL-2.0-or-later", but not the reverse
+//	"GPL-2.0-only WITH Classpath-exception-2.0" does not imply "GPL-2.0-only", nor the reverse
+//
+// Both expressions are always parsed with the license list embedded in the spdxlicenses package.
+// Returns error if either expression cannot be parsed, or a *NormalFormLimitError if the
+// disjunctive normal form of either expression exceeds DefaultMaxClauses or DefaultMaxTerms.
+func Implies(a, b string) (bool, error) {
+	aBranches, bBranches, err := branchesPair(a, b)
+	if err != nil {
+		return false, err
+	}
+	return branchesImply(aBranches, bBranches), nil
+}
+
+// branchesPair parses both expressions and returns their branches in disjunctive normal form.
+func branchesPair(a, b string) ([][]*node, [][]*node, error) {
+	aNode, err := parse(a)
+	if err != nil {
+		return nil, nil, err
+	}
+	bNode, err := parse(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	aBranches, err := dnfBranches(aNode)
+	if err != nil {
+		return nil, nil, err
+	}
+	bBranches, err := dnfBranches(bNode)
+	if err != nil {
+		return nil, nil, err
+	}
+	return aBranches, bBranches, nil
+}
+
+// branchesImply returns true if the branches of expression a imply the branches of expression b.
+// Each branch is an array of licenses that are ANDed, and the branches are ORed.  So, a implies b
+// when every branch of a implies some branch of b.
+func branchesImply(a, b [][]*node) bool {
+	for _, aPart := range a {
+		implied := false
+		for _, bPart := range b {
+			if partImplies(aPart, bPart) {
+				implied = true
+				break
+			}
+		}
+		if !implied {
+			return false
+		}
+	}
+	return true
+}
+
+// partImplies returns true if the ANDed licenses in aPart imply the ANDed licenses in bPart,
+// which is when every license in bPart is implied by some license in aPart.
+func partImplies(aPart, bPart []*node) bool {
+	for _, bLicense := range bPart {
+		implied := false
+		for _, aLicense := range aPart {
+			nodes := &nodePair{firstNode: aLicense, secondNode: bLicense}
+			if nodes.implies() {
+				implied = true
+				break
+			}
+		}
+		if !implied {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,95 @@
+package spdxexp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEquivalent(t *testing.T) {
+	tests := []struct {
+		name       string
+		a          string
+		b          string
+		equivalent bool
+		err        error
+	}{
+		{"same license", "MIT", "mit", true, nil},
+		{"different licenses", "MIT", "ISC", false, nil},
+		{"commutative OR", "MIT OR Apache-2.0", "Apache-2.0 OR MIT", true, nil},
+		{"commutative AND", "(MIT AND ISC) OR Apache-2.0", "Apache-2.0 OR ISC AND MIT", true, nil},
+		{"distributive", "MIT AND (ISC OR Apache-2.0)", "MIT AND ISC OR MIT AND Apache-2.0", true, nil},
+		{"redundant licenses", "MIT OR MIT AND ISC", "MIT", true, nil},
+		{"plus and -or-later", "GPL-2.0+", "GPL-2.0-or-later", true, nil},
+		{"deprecated and -only", "GPL-2.0", "GPL-2.0-only", true, nil},
+		{"-only and -or-later", "GPL-2.0-only", "GPL-2.0-or-later", false, nil},
+		{"different versions", "Apache-1.0+", "Apache-2.0+", false, nil},
+		{"same exception", "GPL-2.0 WITH Classpath-exception-2.0", "GPL-2.0-only WITH Classpath-exception-2.0", true, nil},
+		{"different exceptions", "GPL-2.0 WITH Classpath-exception-2.0", "GPL-2.0 WITH Bison-exception-2.2", false, nil},
+		{"exception and no exception", "GPL-2.0 WITH Classpath-exception-2.0", "GPL-2.0", false, nil},
+		{"license refs", "LicenseRef-X OR MIT", "MIT OR LicenseRef-X", true, nil},
+		{"different document refs", "DocumentRef-a:LicenseRef-X", "DocumentRef-b:LicenseRef-X", false, nil},
+		{"special values", "NOASSERTION", "noassertion", true, nil},
+		{"different special values", "NONE", "NOASSERTION", false, nil},
+		{"invalid first expression", "MIT OR", "MIT", false, errors.New("expected expression following OR, but found none")},
+		{"invalid second expression", "MIT", "Apache2", false, errors.New("unknown license 'Apache2' at offset 0")},
+		{"too many branches", manyANDedORs(20), manyANDedORs(20), false, &NormalFormLimitError{Limit: "terms", Max: DefaultMaxTerms}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			equivalent, err := Equivalent(test.a, test.b)
+			requireEqualError(t, test.err, err)
+			assert.Equal(t, test.equivalent, equivalent)
+
+			// equivalence is symmetric
+			equivalent, _ = Equivalent(test.b, test.a)
+			assert.Equal(t, test.equivalent, equivalent)
+		})
+	}
+}
+
+func TestImplies(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       string
+		b       string
+		implies bool
+		err     error
+	}{
+		{"same license", "MIT", "MIT", true, nil},
+		{"license implies OR", "MIT", "MIT OR Apache-2.0", true, nil},
+		{"OR does not imply license", "MIT OR Apache-2.0", "MIT", false, nil},
+		{"AND implies license", "MIT AND Apache-2.0", "MIT", true, nil},
+		{"license does not imply AND", "MIT", "MIT AND Apache-2.0", false, nil},
+		{"OR implies larger OR", "MIT OR ISC", "ISC OR Apache-2.0 OR MIT", true, nil},
+		{"each OR part must be implied", "MIT OR ISC", "MIT OR Apache-2.0", false, nil},
+		{"later version implies plus", "GPL-3.0-only", "GPL-2.0-or-later", true, nil},
+		{"plus does not imply later version", "GPL-2.0-or-later", "GPL-3.0-only", false, nil},
+		{"same version implies plus", "Apache-2.0", "Apache-2.0+", true, nil},
+		{"earlier version does not imply plus", "Apache-1.0", "Apache-2.0+", false, nil},
+		{"later plus implies earlier plus", "Apache-2.0+", "Apache-1.0+", true, nil},
+		{"earlier plus does not imply later plus", "Apache-1.0+", "Apache-2.0+", false, nil},
+		{"plus does not imply same version", "Apache-2.0+", "Apache-2.0", false, nil},
+		{"different license groups", "GPL-3.0-only", "LGPL-2.0-or-later", false, nil},
+		{"exception implies plus with same exception", "GPL-3.0-only WITH GCC-exception-3.1", "GPL-2.0+ WITH GCC-exception-3.1", true, nil},
+		{"exception does not imply license without exception", "GPL-2.0-only WITH Classpath-exception-2.0", "GPL-2.0-only", false, nil},
+		{"license does not imply license with exception", "GPL-2.0-only", "GPL-2.0-only WITH Classpath-exception-2.0", false, nil},
+		{"license ref implies OR", "LicenseRef-X", "MIT OR LicenseRef-X", true, nil},
+		{"license ref does not imply license", "LicenseRef-X", "MIT", false, nil},
+		{"special value does not imply license", "NONE", "MIT", false, nil},
+		{"invalid expression", "MIT", "MIT AND", false, errors.New("expected expression following AND, but found none")},
+		{"too many branches", "MIT", manyANDedORs(20), false, &NormalFormLimitError{Limit: "terms", Max: DefaultMaxTerms}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			implies, err := Implies(test.a, test.b)
+			requireEqualError(t, test.err, err)
+			assert.Equal(t, test.implies, implies)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package spdxexp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SatisfiesResult explains whether an allowed list satisfies a license expression.
+type SatisfiesResult struct {
+	// Satisfied is true if the allowed list satisfies the expression, the same as Satisfies.
+	Satisfied bool
+	// Branches are the ORed branches of the expression in disjunctive normal form (see ToDNF),
+	// where each branch is a set of licenses that are ANDed.  When Satisfied is true, only the first
+	// branch whose licenses are all allowed is included.  Otherwise, every branch is included with
+	// the licenses that are missing from the allowed list.
+	Branches []BranchResult
+}
+
+// BranchResult explains whether an allowed list satisfies one branch of a license expression.
+type BranchResult struct {
+	// Licenses in the branch, normalized and sorted as by ToDNF.
+	Licenses []string
+	// Matches has the allowed entry that matched each license in the branch that is allowed, in the
+	// same order as Licenses.
+	Matches []LicenseMatch
+	// Missing has the licenses in the branch that are not allowed, in the same order as Licenses.
+	Missing []string
+}
+
+// LicenseMatch is a license in a test expression and the allowed entry that matched it.
+type LicenseMatch struct {
+	// License is the license in the test expression, normalized as by Parse.
+	License string
+	// Allowed is the entry in the allowed list that matched the license, as it appears in the
+	// list.  It is not equal to License when the license is matched by a range (e.g. "Apache-2.0"
+	// is matched by "Apache-1.0+").
+	Allowed string
+}
+
+// SatisfiesDetailed determines if the allowed list of licenses satisfies the test license
+// expression, and explains why.  See SatisfiesResult for the explanation that is returned.
+// Supports options as defined in SatisfiesOptions.
+// Returns error if either the test expression or allowed list cannot be parsed, or a
+// *NormalFormLimitError if the test expression has more branches than DefaultMaxClauses.
+func SatisfiesDetailed(testExpression string, allowedList []string, options SatisfiesOptions) (*SatisfiesResult, error) {
+	allowed, err := compileAllowList(allowedList, options.LicenseList)
+	if err != nil {
+		return nil, err
+	}
+	return allowed.SatisfiesDetailed(testExpression, options)
+}
+
+// SatisfiesDetailed determines if the allowed list satisfies the test license expression, and
+// explains why.  See the package function SatisfiesDetailed.
+func (a *AllowList) SatisfiesDetailed(testExpression string, options SatisfiesOptions) (*SatisfiesResult, error) {
+	testExpression = strings.TrimSpace(testExpression)
+	if value, ok := specialValue(testExpression); ok {
+		if err := options.SpecialValues.check(value); err != nil {
+			return nil, err
+		}
+	}
+
+	expressionNode, err := parseWithLicenses(testExpression, a.licenseList(options))
+	if err != nil {
+		return nil, err
+	}
+	branches, err := dnfBranches(expressionNode)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SatisfiesResult{}
+	for _, licenses := range branches {
+		branch := BranchResult{}
+		for i, allowed := range a.matchBranch(licenses) {
+			license := *licenses[i].reconstructedLicenseString()
+			branch.Licenses = append(branch.Licenses, license)
+			if allowed != "" {
+				branch.Matches = append(branch.Matches, LicenseMatch{License: license, Allowed: allowed})
+			} else {
+				branch.Missing = append(branch.Missing, license)
+			}
+		}
+		if len(branch.Missing) == 0 {
+			return &SatisfiesResult{Satisfied: true, Branches: []BranchResult{branch}}, nil
+		}
+		result.Branches = append(result.Branches, branch)
+	}
+	return result, nil
+}
+
+// Explain returns a human readable explanation of the result, with one line for the result and
+// one line for each license in the satisfying branch or for each branch that is not satisfied.
+//
+// Example:
+//
+//	satisfied by "Apache-2.0 AND MIT"
+//	  Apache-2.0 is allowed by "Apache-1.0+"
+//	  MIT is allowed by "MIT"
+//
+//	not satisfied
+//	  "GPL-2.0-only AND MIT" is missing GPL-2.0-only
+//	  "ISC" is missing ISC
+func (r *SatisfiesResult) Explain() string {
+	var b strings.Builder
+	if r.Satisfied {
+		branch := r.Branches[0]
+		fmt.Fprintf(&b, "satisfied by %q\n", strings.Join(branch.Licenses, " AND "))
+		for _, match := range branch.Matches {
+			fmt.Fprintf(&b, "  %s is allowed by %q\n", match.License, match.Allowed)
+		}
+		return b.String()
+	}
+
+	b.WriteString("not satisfied\n")
+	for _, branch := range r.Branches {
+		fmt.Fprintf(&b, "  %q is missing %s\n", strings.Join(branch.Licenses, " AND "), strings.Join(branch.Missing, ", "))
+	}
+	return b.String()
+}
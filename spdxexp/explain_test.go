@@ -0,0 +1,113 @@
+package spdxexp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSatisfiesDetailed(t *testing.T) {
+	tests := []struct {
+		name           string
+		testExpression string
+		allowedList    []string
+		options        SatisfiesOptions
+		result         *SatisfiesResult
+		err            error
+	}{
+		{"license", "MIT", []string{"mit", "ISC"}, SatisfiesOptions{},
+			&SatisfiesResult{Satisfied: true, Branches: []BranchResult{
+				{Licenses: []string{"MIT"}, Matches: []LicenseMatch{{License: "MIT", Allowed: "mit"}}},
+			}}, nil},
+		{"range match", "MIT AND (GPL-2.0 OR Apache-2.0)", []string{"MIT", "Apache-1.0+"}, SatisfiesOptions{},
+			&SatisfiesResult{Satisfied: true, Branches: []BranchResult{
+				{Licenses: []string{"Apache-2.0", "MIT"}, Matches: []LicenseMatch{
+					{License: "Apache-2.0", Allowed: "Apache-1.0+"},
+					{License: "MIT", Allowed: "MIT"},
+				}},
+			}}, nil},
+		{"license ref and exception", "LicenseRef-X AND GPL-2.0-or-later WITH Classpath-exception-2.0",
+			[]string{"GPL-2.0+ WITH Classpath-exception-2.0", "LicenseRef-X"}, SatisfiesOptions{},
+			&SatisfiesResult{Satisfied: true, Branches: []BranchResult{
+				{Licenses: []string{"GPL-2.0-or-later WITH Classpath-exception-2.0", "LicenseRef-X"}, Matches: []LicenseMatch{
+					{License: "GPL-2.0-or-later WITH Classpath-exception-2.0", Allowed: "GPL-2.0+ WITH Classpath-exception-2.0"},
+					{License: "LicenseRef-X", Allowed: "LicenseRef-X"},
+				}},
+			}}, nil},
+		{"not satisfied", "(MIT AND GPL-2.0-only) OR ISC OR (Apache-2.0 AND LicenseRef-X)", []string{"MIT", "Apache-2.0"}, SatisfiesOptions{},
+			&SatisfiesResult{Satisfied: false, Branches: []BranchResult{
+				{Licenses: []string{"Apache-2.0", "LicenseRef-X"},
+					Matches: []LicenseMatch{{License: "Apache-2.0", Allowed: "Apache-2.0"}},
+					Missing: []string{"LicenseRef-X"}},
+				{Licenses: []string{"GPL-2.0-only", "MIT"},
+					Matches: []LicenseMatch{{License: "MIT", Allowed: "MIT"}},
+					Missing: []string{"GPL-2.0-only"}},
+				{Licenses: []string{"ISC"}, Missing: []string{"ISC"}},
+			}}, nil},
+		{"special value", "NONE", []string{"MIT", "NONE"}, SatisfiesOptions{SpecialValues: AllowSpecialValues},
+			&SatisfiesResult{Satisfied: true, Branches: []BranchResult{
+				{Licenses: []string{"NONE"}, Matches: []LicenseMatch{{License: "NONE", Allowed: "NONE"}}},
+			}}, nil},
+		{"special value rejected", "NONE", []string{"MIT", "NONE"}, SatisfiesOptions{}, nil,
+			errors.New("special value is not allowed: NONE")},
+		{"invalid expression", "MIT OR", []string{"MIT"}, SatisfiesOptions{}, nil,
+			errors.New("expected expression following OR, but found none")},
+		{"bundle", "MIT AND Apache-2.0 AND ISC", []string{"Apache-1.0+ AND MIT", "ISC"}, SatisfiesOptions{},
+			&SatisfiesResult{Satisfied: true, Branches: []BranchResult{
+				{Licenses: []string{"Apache-2.0", "ISC", "MIT"}, Matches: []LicenseMatch{
+					{License: "Apache-2.0", Allowed: "Apache-1.0+ AND MIT"},
+					{License: "ISC", Allowed: "ISC"},
+					{License: "MIT", Allowed: "Apache-1.0+ AND MIT"},
+				}},
+			}}, nil},
+		{"incomplete bundle", "MIT AND ISC", []string{"Apache-2.0 AND MIT", "ISC"}, SatisfiesOptions{},
+			&SatisfiesResult{Satisfied: false, Branches: []BranchResult{
+				{Licenses: []string{"ISC", "MIT"},
+					Matches: []LicenseMatch{{License: "ISC", Allowed: "ISC"}},
+					Missing: []string{"MIT"}},
+			}}, nil},
+		{"invalid allowed list", "MIT", []string{"MIT AND"}, SatisfiesOptions{}, nil,
+			errors.New("invalid allowedList entry \"MIT AND\" at index 0: expected expression following AND, but found none")},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			result, err := SatisfiesDetailed(test.testExpression, test.allowedList, test.options)
+			requireEqualError(t, test.err, err)
+			assert.Equal(t, test.result, result)
+			if test.err != nil {
+				return
+			}
+
+			// the result is the same as Satisfies
+			satisfied, err := SatisfiesWithOptions(test.testExpression, test.allowedList, test.options)
+			require.NoError(t, err)
+			assert.Equal(t, satisfied, result.Satisfied)
+		})
+	}
+}
+
+func TestSatisfiesDetailedLimit(t *testing.T) {
+	_, err := SatisfiesDetailed(manyANDedORs(20), []string{"MIT"}, SatisfiesOptions{})
+	var limitErr *NormalFormLimitError
+	assert.True(t, errors.As(err, &limitErr))
+}
+
+func TestSatisfiesResultExplain(t *testing.T) {
+	result, err := SatisfiesDetailed("MIT AND (GPL-2.0 OR Apache-2.0)", []string{"MIT", "Apache-1.0+"}, SatisfiesOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, `satisfied by "Apache-2.0 AND MIT"
+  Apache-2.0 is allowed by "Apache-1.0+"
+  MIT is allowed by "MIT"
+`, result.Explain())
+
+	result, err = SatisfiesDetailed("(MIT AND GPL-2.0-only) OR ISC", []string{"MIT"}, SatisfiesOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, `not satisfied
+  "GPL-2.0-only AND MIT" is missing GPL-2.0-only
+  "ISC" is missing ISC
+`, result.Explain())
+}
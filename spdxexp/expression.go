@@ -0,0 +1,179 @@
+package spdxexp
+
+// Expression is a parsed SPDX license expression.  An expression is a tree where AND and OR
+// nodes have a left and right sub-expression and License, LicenseRef, and With nodes are leaves.
+// The special values NONE and NOASSERTION are parsed as an expression with a single node.
+// Use Kind to determine the type of the node and the accessors to read its values.
+type Expression struct {
+	node  *node
+	spans map[*node]Span // location of each node in the parsed string
+}
+
+// Span is a range of bytes in the string an expression was parsed from.  Start is the offset of
+// the first byte and End is the offset following the last byte, so that the text of the span is
+// source[span.Start:span.End].
+type Span struct {
+	Start int
+	End   int
+}
+
+// Kind identifies the type of node at the root of an Expression.
+type Kind uint8
+
+const (
+	// LicenseKind is a license identifier with an optional + (e.g. "MIT", "Apache-1.0+").
+	LicenseKind Kind = iota
+	// LicenseRefKind is a license reference with an optional document reference
+	// (e.g. "LicenseRef-MIT-Style-2", "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2").
+	LicenseRefKind
+	// AndKind is a conjunction of two expressions (e.g. "MIT AND Apache-2.0").
+	AndKind
+	// OrKind is a disjunction of two expressions (e.g. "MIT OR Apache-2.0").
+	OrKind
+	// WithKind is a license or license reference with an exception or custom addition
+	// (e.g. "GPL-2.0-or-later WITH Bison-exception-2.2", "LicenseRef-X WITH AdditionRef-Y").
+	WithKind
+	// NoneKind is the special value NONE, which means that there is no license.
+	NoneKind
+	// NoAssertionKind is the special value NOASSERTION, which means that the license was not
+	// determined.
+	NoAssertionKind
+)
+
+// String returns the name of the kind.
+func (k Kind) String() string {
+	switch k {
+	case LicenseKind:
+		return "License"
+	case LicenseRefKind:
+		return "LicenseRef"
+	case AndKind:
+		return "And"
+	case OrKind:
+		return "Or"
+	case WithKind:
+		return "With"
+	case NoneKind:
+		return "None"
+	case NoAssertionKind:
+		return "NoAssertion"
+	}
+	return "Unknown"
+}
+
+// Parse parses an SPDX license expression.  License and exception identifiers are normalized
+// to the case used in the SPDX license list.  The special values NONE and NOASSERTION are
+// accepted, ignoring case, but only as the entire expression.
+// Returns an error if the expression is not a valid SPDX expression.
+func Parse(expression string) (*Expression, error) {
+	n, spans, err := parseWithSpans(expression, nil)
+	if err != nil {
+		return nil, err
+	}
+	return newExpression(n, spans), nil
+}
+
+func newExpression(n *node, spans map[*node]Span) *Expression {
+	if n == nil {
+		return nil
+	}
+	return &Expression{node: n, spans: spans}
+}
+
+// Kind returns the kind of the node at the root of the expression.
+func (e *Expression) Kind() Kind {
+	n := e.node
+	switch {
+	case n.isAndExpression():
+		return AndKind
+	case n.isOrExpression():
+		return OrKind
+	case n.hasException():
+		return WithKind
+	case n.isLicenseRef():
+		return LicenseRefKind
+	case n.isSpecialValue():
+		if *n.specialValue() == noneValue {
+			return NoneKind
+		}
+		return NoAssertionKind
+	}
+	return LicenseKind
+}
+
+// Left returns the left sub-expression of an AND or OR expression; otherwise, nil.
+func (e *Expression) Left() *Expression {
+	return newExpression(e.node.left(), e.spans)
+}
+
+// Right returns the right sub-expression of an AND or OR expression; otherwise, nil.
+func (e *Expression) Right() *Expression {
+	return newExpression(e.node.right(), e.spans)
+}
+
+// License returns the license identifier of a License or With expression; otherwise, "".
+func (e *Expression) License() string {
+	if license := e.node.license(); license != nil {
+		return *license
+	}
+	return ""
+}
+
+// HasPlus returns true if the license of a License or With expression allows later versions
+// (e.g. "Apache-1.0+", "GPL-2.0-or-later"); otherwise, false.
+func (e *Expression) HasPlus() bool {
+	return e.node.hasPlus()
+}
+
+// Exception returns the exception identifier or custom addition of a With expression (e.g.
+// "Bison-exception-2.2", "AdditionRef-Y", "DocumentRef-spdx-tool-1.2:AdditionRef-Y");
+// otherwise, "".
+func (e *Expression) Exception() string {
+	if exception := e.node.exception(); exception != nil {
+		return *exception
+	}
+	return ""
+}
+
+// LicenseRef returns the id following "LicenseRef-" of a LicenseRef expression or of a With
+// expression whose license is a license reference; otherwise, "".
+func (e *Expression) LicenseRef() string {
+	if ref := e.node.licenseRef(); ref != nil {
+		return *ref
+	}
+	return ""
+}
+
+// HasDocumentRef returns true if a LicenseRef expression is scoped to a document; otherwise, false.
+func (e *Expression) HasDocumentRef() bool {
+	return e.node.hasDocumentRef()
+}
+
+// DocumentRef returns the id following "DocumentRef-" of a LicenseRef expression; otherwise, "".
+func (e *Expression) DocumentRef() string {
+	if ref := e.node.documentRef(); ref != nil {
+		return *ref
+	}
+	return ""
+}
+
+// Span returns the location of the expression in the string it was parsed from.  Spans refer to
+// the original text even when it was normalized (e.g. "Apache-1.0-or-later" is parsed as
+// "Apache-1.0+", but its span still covers the "-or-later" suffix).  The span of a License or
+// With expression includes the + and exception, and the span of a parenthesized sub-expression
+// does not include the parentheses.  Returns false if the location is unknown (e.g. for
+// expressions created by License or And).
+func (e *Expression) Span() (Span, bool) {
+	span, ok := e.spans[e.node]
+	return span, ok
+}
+
+// String returns the normalized SPDX representation of the expression.  Parentheses are only
+// included where they are required by operator precedence, so expressions with the same tree
+// have the same string whether they were parsed or built with And, Or, License, With, and Ref.
+func (e *Expression) String() string {
+	if s := e.node.reconstructedLicenseString(); s != nil {
+		return *s
+	}
+	return ""
+}
@@ -0,0 +1,174 @@
+package spdxexp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExpression(t *testing.T) {
+	tests := []struct {
+		name        string
+		expression  string
+		kind        Kind
+		license     string
+		hasPlus     bool
+		exception   string
+		licenseRef  string
+		documentRef string
+		str         string
+	}{
+		{"license", "mit", LicenseKind, "MIT", false, "", "", "", "MIT"},
+		{"license with plus", "Apache-1.0+", LicenseKind, "Apache-1.0", true, "", "", "", "Apache-1.0+"},
+		{"license -or-later", "GPL-2.0-or-later", LicenseKind, "GPL-2.0-or-later", true, "", "", "", "GPL-2.0-or-later"},
+		{"license with exception", "GPL-2.0-or-later WITH Bison-exception-2.2", WithKind,
+			"GPL-2.0-or-later", true, "Bison-exception-2.2", "", "", "GPL-2.0-or-later WITH Bison-exception-2.2"},
+		{"license ref", "LicenseRef-MIT-Style-2", LicenseRefKind, "", false, "", "MIT-Style-2", "", "LicenseRef-MIT-Style-2"},
+		{"license ref with document ref", "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2", LicenseRefKind,
+			"", false, "", "MIT-Style-2", "spdx-tool-1.2", "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2"},
+		{"license with addition ref", "GPL-2.0-only WITH DocumentRef-spdx-tool-1.2:AdditionRef-Custom", WithKind,
+			"GPL-2.0-only", false, "DocumentRef-spdx-tool-1.2:AdditionRef-Custom", "", "", "GPL-2.0-only WITH DocumentRef-spdx-tool-1.2:AdditionRef-Custom"},
+		{"license ref with addition ref", "LicenseRef-X WITH AdditionRef-Custom", WithKind,
+			"", false, "AdditionRef-Custom", "X", "", "LicenseRef-X WITH AdditionRef-Custom"},
+		{"none", "NONE", NoneKind, "", false, "", "", "", "NONE"},
+		{"no assertion", " noassertion ", NoAssertionKind, "", false, "", "", "", "NOASSERTION"},
+		{"and expression", "MIT AND Apache-2.0", AndKind, "", false, "", "", "", "MIT AND Apache-2.0"},
+		{"or expression", "(MIT OR Apache-2.0)", OrKind, "", false, "", "", "", "MIT OR Apache-2.0"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			expression, err := Parse(test.expression)
+			require.NoError(t, err)
+			assert.Equal(t, test.kind, expression.Kind())
+			assert.Equal(t, test.license, expression.License())
+			assert.Equal(t, test.hasPlus, expression.HasPlus())
+			assert.Equal(t, test.exception, expression.Exception())
+			assert.Equal(t, test.licenseRef, expression.LicenseRef())
+			assert.Equal(t, test.documentRef, expression.DocumentRef())
+			assert.Equal(t, test.documentRef != "", expression.HasDocumentRef())
+			assert.Equal(t, test.str, expression.String())
+		})
+	}
+}
+
+func TestParseExpressionError(t *testing.T) {
+	expression, err := Parse("MIT AND")
+	assert.EqualError(t, err, "expected expression following AND, but found none")
+	assert.Nil(t, expression)
+}
+
+func TestParseExpressionTruncated(t *testing.T) {
+	tests := []string{
+		"(", "((", ")", "MIT AND (", "MIT OR (", "(MIT", "(MIT AND", "MIT AND (ISC OR", "MIT WITH",
+		"DocumentRef-x", "DocumentRef-x:", "MIT AND DocumentRef-x", "GPL-2.0-only WITH DocumentRef-x:",
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test, func(t *testing.T) {
+			var err error
+			require.NotPanics(t, func() {
+				_, err = Parse(test)
+			})
+			assert.Error(t, err)
+		})
+	}
+
+	// every prefix of valid expressions either parses or returns an error
+	for _, valid := range []string{
+		"(MIT OR Apache-2.0+) AND GPL-2.0-only WITH Classpath-exception-2.0",
+		"DocumentRef-spdx-tool-1.2:LicenseRef-X WITH DocumentRef-spdx-tool-1.2:AdditionRef-Y OR ((ISC))",
+	} {
+		for i := 1; i <= len(valid); i++ {
+			require.NotPanics(t, func() {
+				_, _ = Parse(valid[:i])
+			}, valid[:i])
+		}
+	}
+}
+
+func TestExpressionTree(t *testing.T) {
+	expression, err := Parse("(MIT OR Apache-2.0) AND LicenseRef-X")
+	require.NoError(t, err)
+
+	assert.Equal(t, AndKind, expression.Kind())
+	left := expression.Left()
+	require.NotNil(t, left)
+	assert.Equal(t, OrKind, left.Kind())
+	assert.Equal(t, "MIT", left.Left().License())
+	assert.Equal(t, "Apache-2.0", left.Right().License())
+	assert.Equal(t, LicenseRefKind, expression.Right().Kind())
+	assert.Equal(t, "X", expression.Right().LicenseRef())
+
+	// leaves have no sub-expressions
+	assert.Nil(t, left.Left().Left())
+	assert.Nil(t, left.Left().Right())
+	assert.Equal(t, "(MIT OR Apache-2.0) AND LicenseRef-X", expression.String())
+}
+
+func TestKindString(t *testing.T) {
+	assert.Equal(t, "License", LicenseKind.String())
+	assert.Equal(t, "LicenseRef", LicenseRefKind.String())
+	assert.Equal(t, "And", AndKind.String())
+	assert.Equal(t, "Or", OrKind.String())
+	assert.Equal(t, "With", WithKind.String())
+	assert.Equal(t, "None", NoneKind.String())
+	assert.Equal(t, "NoAssertion", NoAssertionKind.String())
+}
+
+func TestExpressionSpan(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		spans      []string // source text of each node in pre-order
+	}{
+		{"license", "  MIT  ", []string{"MIT"}},
+		{"license with plus", "Apache-1.0+", []string{"Apache-1.0+"}},
+		{"license -or-later normalized to plus", "Apache-1.0-or-later AND MIT",
+			[]string{"Apache-1.0-or-later AND MIT", "Apache-1.0-or-later", "MIT"}},
+		{"license plus normalized to -or-later", "GPL-2.0+ OR MIT",
+			[]string{"GPL-2.0+ OR MIT", "GPL-2.0+", "MIT"}},
+		{"license -only", "mit OR GPL-2.0-only", []string{"mit OR GPL-2.0-only", "mit", "GPL-2.0-only"}},
+		{"license with exception", "GPL-2.0-or-later WITH Bison-exception-2.2",
+			[]string{"GPL-2.0-or-later WITH Bison-exception-2.2"}},
+		{"license ref with document ref", "MIT OR DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2",
+			[]string{"MIT OR DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2", "MIT", "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2"}},
+		{"parentheses", "( Apache-1.0-or-later OR MIT ) AND ISC",
+			[]string{"( Apache-1.0-or-later OR MIT ) AND ISC", "Apache-1.0-or-later OR MIT", "Apache-1.0-or-later", "MIT", "ISC"}},
+		{"nested", "MIT AND (ISC OR (0BSD AND Apache-2.0))",
+			[]string{"MIT AND (ISC OR (0BSD AND Apache-2.0))", "MIT", "ISC OR (0BSD AND Apache-2.0)", "ISC", "0BSD AND Apache-2.0", "0BSD", "Apache-2.0"}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			expression, err := Parse(test.expression)
+			require.NoError(t, err)
+			assert.Equal(t, test.spans, spanTexts(t, test.expression, expression))
+		})
+	}
+}
+
+func TestExpressionSpanRecover(t *testing.T) {
+	source := "(MIT OR FOO) ANDD Apache-1.0-or-later"
+	expression, err := ParseWithOptions(source, ParseOptions{Recover: true})
+	require.Error(t, err)
+	assert.Equal(t, []string{"(MIT OR FOO) ANDD Apache-1.0-or-later", "MIT", "Apache-1.0-or-later"},
+		spanTexts(t, source, expression))
+}
+
+// spanTexts returns the source text of the span of each node of the expression in pre-order.
+func spanTexts(t *testing.T, source string, expression *Expression) []string {
+	t.Helper()
+	if expression == nil {
+		return nil
+	}
+	span, ok := expression.Span()
+	require.True(t, ok)
+	texts := []string{source[span.Start:span.End]}
+	texts = append(texts, spanTexts(t, source, expression.Left())...)
+	return append(texts, spanTexts(t, source, expression.Right())...)
+}
@@ -5,13 +5,37 @@ import (
 	"slices"
 )
 
+// ExtractLicensesOptions controls how ExtractLicensesWithOptions extracts licenses.
+type ExtractLicensesOptions struct {
+	// SpecialValues controls how NONE and NOASSERTION are handled.  When they are allowed, the
+	// special value is returned as the only license.  Otherwise, an error wrapping
+	// ErrSpecialValueRejected or ErrSpecialValueNeedsReview is returned.
+	SpecialValues SpecialValueHandling
+
+	// LicenseList is the license list that license and exception ids are looked up in.  When nil,
+	// the list embedded in the spdxlicenses package is used.
+	LicenseList *LicenseList
+}
+
 // ExtractLicenses extracts licenses from the given expression without duplicates.
 // Returns an array of licenses or error if error occurs during processing.
 func ExtractLicenses(expression string) ([]string, error) {
-	node, err := parse(expression)
+	return ExtractLicensesWithOptions(expression, ExtractLicensesOptions{})
+}
+
+// ExtractLicensesWithOptions extracts licenses from the given expression without duplicates.
+// Supports options as defined in ExtractLicensesOptions.
+// Returns an array of licenses or error if error occurs during processing.
+func ExtractLicensesWithOptions(expression string, options ExtractLicensesOptions) ([]string, error) {
+	node, err := parseWithLicenses(expression, options.LicenseList)
 	if err != nil {
 		return nil, err
 	}
+	if node.isSpecialValue() {
+		if err := options.SpecialValues.check(*node.specialValue()); err != nil {
+			return nil, err
+		}
+	}
 
 	seen := map[string]struct{}{}
 	collectExtractedLicenses(node, seen)
@@ -74,6 +74,29 @@ func TestExtractLicenses(t *testing.T) {
 	}
 }
 
+func TestExtractLicensesWithOptions_SpecialValues(t *testing.T) {
+	tests := []struct {
+		name              string
+		inputExpression   string
+		options           ExtractLicensesOptions
+		extractedLicenses []string
+		err               error
+	}{
+		{"Special value rejected by default", "NONE", ExtractLicensesOptions{}, nil, ErrSpecialValueRejected},
+		{"Special value needs review", "NOASSERTION", ExtractLicensesOptions{SpecialValues: ReviewSpecialValues}, nil, ErrSpecialValueNeedsReview},
+		{"Special value allowed", "noassertion", ExtractLicensesOptions{SpecialValues: AllowSpecialValues}, []string{"NOASSERTION"}, nil},
+		{"Licenses are extracted when special values are allowed", "MIT AND ISC", ExtractLicensesOptions{SpecialValues: AllowSpecialValues}, []string{"MIT", "ISC"}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			licenses, err := ExtractLicensesWithOptions(test.inputExpression, test.options)
+			assert.ElementsMatch(t, test.extractedLicenses, licenses)
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
 func TestExtractLicensesLicenseRefAndDedup(t *testing.T) {
 	licenses, err := ExtractLicenses("(LicenseRef-custom OR LicenseRef-custom) AND (DocumentRef-spdx-tool-1.2:LicenseRef-custom OR MIT)")
 	assert.NoError(t, err)
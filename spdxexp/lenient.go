@@ -0,0 +1,542 @@
+package spdxexp
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Confidence is how likely it is that a lenient rewrite preserves the meaning of the original text.
+type Confidence uint8
+
+const (
+	// LowConfidence is a guess that should be reviewed (e.g. "," read as AND).
+	LowConfidence Confidence = iota
+	// MediumConfidence is a common convention that is usually right (e.g. "MIT/Apache-2.0" read as
+	// "MIT OR Apache-2.0", or "GPLv2" read as "GPL-2.0-only").
+	MediumConfidence
+	// HighConfidence is a rewrite that only changes the spelling (e.g. "mit or apache-2.0",
+	// "The MIT License", or "GPLv2+").
+	HighConfidence
+)
+
+// String returns the name of the confidence level.
+func (c Confidence) String() string {
+	switch c {
+	case LowConfidence:
+		return "Low"
+	case MediumConfidence:
+		return "Medium"
+	case HighConfidence:
+		return "High"
+	}
+	return "Unknown"
+}
+
+// Rewrite describes a change ParseLenient or Modernize made to the original text.
+type Rewrite struct {
+	// Original is the text in the original expression that was rewritten.
+	Original string
+	// Replacement is the SPDX text that replaced Original.
+	Replacement string
+	// Span is the location of Original in the original expression.
+	Span Span
+	// Reason describes the heuristic that was applied.
+	Reason string
+	// Confidence is how likely the rewrite preserves the meaning of Original.
+	Confidence Confidence
+}
+
+// LenientResult is the result of ParseLenient.
+type LenientResult struct {
+	// Expression is the parsed expression.  Its spans refer to the original expression.
+	Expression *Expression
+	// Rewrites lists every change made to the original expression in the order they occur.
+	Rewrites []Rewrite
+	// Confidence is the lowest confidence of all rewrites, or HighConfidence if there are none.
+	Confidence Confidence
+}
+
+// Reasons reported in Rewrite.Reason.
+const (
+	reasonOperatorCase = "operator is not all uppercase or all lowercase"
+	reasonSlash        = "\"/\" between licenses read as OR"
+	reasonComma        = "\",\" between licenses read as AND"
+	reasonLicenseName  = "license name normalized to SPDX id"
+	reasonAlias        = "license name is a common alias"
+	reasonAddedMinor   = "assumed minor version 0"
+	reasonAddedClause  = "version read as BSD clause count"
+	reasonAssumedOnly  = "assumed -only for a license without + or \"or later\""
+	reasonOrLater      = "\"or later\" read as +"
+)
+
+// lenientFillers are words that are dropped from license names (e.g. "The MIT License").
+var lenientFillers = map[string]bool{
+	"the":      true,
+	"license":  true,
+	"licence":  true,
+	"licensed": true,
+	"version":  true,
+	"gnu":      true,
+	"software": true,
+}
+
+// lenientPhrases are multi-word license names, after fillers are dropped, that are replaced with
+// the abbreviation used in SPDX ids.  Longer phrases are listed first.
+var lenientPhrases = []struct {
+	words []string
+	abbr  string
+}{
+	{[]string{"lesser", "general", "public"}, "lgpl"},
+	{[]string{"library", "general", "public"}, "lgpl"},
+	{[]string{"affero", "general", "public"}, "agpl"},
+	{[]string{"general", "public"}, "gpl"},
+	{[]string{"lesser", "gpl"}, "lgpl"},
+	{[]string{"affero", "gpl"}, "agpl"},
+	{[]string{"mozilla", "public"}, "mpl"},
+	{[]string{"eclipse", "public"}, "epl"},
+}
+
+// lenientAliases maps license names, after fillers are dropped and phrases are replaced, that do
+// not resemble their SPDX id.
+var lenientAliases = map[string]struct {
+	id         string
+	confidence Confidence
+}{
+	"expat":          {"MIT", MediumConfidence},
+	"new bsd":        {"BSD-3-Clause", MediumConfidence},
+	"bsd new":        {"BSD-3-Clause", MediumConfidence},
+	"modified bsd":   {"BSD-3-Clause", MediumConfidence},
+	"revised bsd":    {"BSD-3-Clause", MediumConfidence},
+	"3-clause bsd":   {"BSD-3-Clause", HighConfidence},
+	"simplified bsd": {"BSD-2-Clause", MediumConfidence},
+	"freebsd":        {"BSD-2-Clause", MediumConfidence},
+	"2-clause bsd":   {"BSD-2-Clause", HighConfidence},
+	"boost":          {"BSL-1.0", MediumConfidence},
+	"cc0":            {"CC0-1.0", MediumConfidence},
+	"apache":         {"Apache-2.0", LowConfidence},
+	"asl":            {"Apache-2.0", LowConfidence},
+}
+
+// lenientVersion matches a license name followed by a version (e.g. "gplv2", "apache-2", "bsd-3").
+var lenientVersion = regexp.MustCompile(`^(.*?[a-z])[-_]?v?(\d+(?:\.\d+)*)$`)
+
+// ParseLenient parses a license expression as it is commonly written in package metadata, which is
+// often not valid SPDX.  The expression is rewritten to a valid SPDX expression using these
+// heuristics:
+//   - operators in mixed case (e.g. "MIT Or Apache-2.0") are uppercased
+//   - "/" between licenses is read as OR and "," is read as AND
+//   - "or later" following a license is read as +
+//   - the words "the", "license", "licence", "licensed", "version", "gnu", and "software" are
+//     dropped from license names (e.g. "The MIT License" is MIT)
+//   - spelled out names are abbreviated (e.g. "General Public" is GPL, "Mozilla Public" is MPL)
+//   - versions are normalized (e.g. "GPLv2+" is GPL-2.0-or-later, "Apache 2.0" is Apache-2.0,
+//     and "BSD-3" is BSD-3-Clause)
+//   - licenses with -only and -or-later variants are assumed to be -only unless followed by +
+//   - common aliases (e.g. "Expat" is MIT, "Simplified BSD" is BSD-2-Clause) are replaced
+//
+// Each rewrite is reported with the confidence that it preserves the meaning of the original
+// text.  Text that is already valid SPDX is not changed.  The heuristics and the rewritten
+// expression always use the license list embedded in the spdxlicenses package.  Returns an error
+// if the rewritten expression is not a valid SPDX expression.  Offsets in the error refer to the
+// original expression.
+func ParseLenient(expression string) (*LenientResult, error) {
+	l := &lenientParser{source: expression, lexemes: lexLenient(expression)}
+	l.rewrite()
+
+	rewritten := l.output()
+	n, spans, err := parseWithSpans(rewritten, nil)
+	if err != nil {
+		var parseErr *ParseError
+		if errors.As(err, &parseErr) {
+			return nil, parseErr.withOffset(l.toSource(parseErr.Offset))
+		}
+		return nil, err
+	}
+
+	sourceSpans := make(map[*node]Span, len(spans))
+	for n, span := range spans {
+		sourceSpans[n] = Span{Start: l.toSource(span.Start), End: l.toSource(span.End)}
+	}
+
+	result := &LenientResult{
+		Expression: newExpression(n, sourceSpans),
+		Rewrites:   l.rewrites,
+		Confidence: HighConfidence,
+	}
+	for _, rewrite := range l.rewrites {
+		if rewrite.Confidence < result.Confidence {
+			result.Confidence = rewrite.Confidence
+		}
+	}
+	return result, nil
+}
+
+// lexeme is a word or punctuation in an expression parsed by ParseLenient.
+type lexeme struct {
+	text  string
+	start int
+	end   int
+}
+
+// Split an expression into words separated by whitespace and the punctuation "(", ")", "/", and ",".
+// Each punctuation character is a separate lexeme.
+func lexLenient(expression string) []lexeme {
+	var lexemes []lexeme
+	start := -1
+	for i, r := range expression {
+		punctuation := r == '(' || r == ')' || r == '/' || r == ','
+		if unicode.IsSpace(r) || punctuation {
+			if start >= 0 {
+				lexemes = append(lexemes, lexeme{text: expression[start:i], start: start, end: i})
+				start = -1
+			}
+			if punctuation {
+				lexemes = append(lexemes, lexeme{text: expression[i : i+1], start: i, end: i + 1})
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		lexemes = append(lexemes, lexeme{text: expression[start:], start: start, end: len(expression)})
+	}
+	return lexemes
+}
+
+// piece is a token of the rewritten expression and the span of the source text it came from.
+type piece struct {
+	text        string
+	sourceStart int
+	sourceEnd   int
+	start       int // offset in the rewritten expression
+	end         int
+}
+
+type lenientParser struct {
+	source   string
+	lexemes  []lexeme
+	index    int
+	pieces   []piece
+	rewrites []Rewrite
+}
+
+// Rewrite the lexemes to pieces of an SPDX expression.
+func (l *lenientParser) rewrite() {
+	for l.index < len(l.lexemes) {
+		lex := l.lexemes[l.index]
+		switch {
+		case lex.text == "(" || lex.text == ")":
+			l.emit(lex.text, lex.start, lex.end)
+			l.index++
+		case lex.text == "/":
+			l.replace("OR", lex.start, lex.end, reasonSlash, MediumConfidence)
+			l.index++
+		case lex.text == ",":
+			l.replace("AND", lex.start, lex.end, reasonComma, LowConfidence)
+			l.index++
+		case isLenientOperator(lex.text):
+			operator := strings.ToUpper(lex.text)
+			if operator == lex.text || strings.ToLower(lex.text) == lex.text {
+				l.emit(operator, lex.start, lex.end)
+			} else {
+				l.replace(operator, lex.start, lex.end, reasonOperatorCase, HighConfidence)
+			}
+			l.index++
+		default:
+			l.rewriteLicense()
+		}
+	}
+}
+
+// Return true if the word is an operator in any case.
+func isLenientOperator(word string) bool {
+	switch strings.ToUpper(word) {
+	case "AND", "OR", "WITH":
+		return true
+	}
+	return false
+}
+
+// Rewrite the run of words starting at the current lexeme that names a single license.
+func (l *lenientParser) rewriteLicense() {
+	var words []lexeme
+	orLater := false
+	for l.index < len(l.lexemes) {
+		lex := l.lexemes[l.index]
+		if n := l.orLaterLength(); n > 0 && len(words) > 0 {
+			// "or later" belongs to the license rather than being an OR operator
+			orLater = true
+			words = append(words, l.lexemes[l.index:l.index+n]...)
+			l.index += n
+			break
+		}
+		if lex.text == "," && len(words) > 0 && l.index+1 < len(l.lexemes) &&
+			strings.EqualFold(l.lexemes[l.index+1].text, "version") {
+			// comma inside a name (e.g. "Apache License, Version 2.0")
+			words = append(words, lex)
+			l.index++
+			continue
+		}
+		if isLenientOperator(lex.text) || strings.ContainsAny(lex.text, "()/,") {
+			break
+		}
+		words = append(words, lex)
+		l.index++
+	}
+
+	start, end := words[0].start, words[len(words)-1].end
+	original := l.source[start:end]
+	if len(words) == 1 && isStrictLicense(original) {
+		l.emit(original, start, end)
+		return
+	}
+
+	id, confidence, reasons := lenientLicense(words, orLater)
+	if id == "" {
+		// leave the words unchanged so that the parser reports the problem
+		for _, word := range words {
+			if word.text != "," {
+				l.emit(word.text, word.start, word.end)
+			}
+		}
+		return
+	}
+	l.replace(id, start, end, strings.Join(reasons, "; "), confidence)
+}
+
+// Return the number of lexemes in an "or later" phrase at the current lexeme (e.g. "or later",
+// "or any later version"), or 0 if there is not one.
+func (l *lenientParser) orLaterLength() int {
+	var words []string
+	for i := l.index; i < len(l.lexemes) && i < l.index+4; i++ {
+		words = append(words, strings.ToLower(l.lexemes[i].text))
+	}
+	phrases := [][]string{
+		{"or", "any", "later", "version"},
+		{"or", "any", "later"},
+		{"or", "later"},
+		{"or", "newer"},
+	}
+	for _, phrase := range phrases {
+		if hasPrefixWords(words, phrase) {
+			return len(phrase)
+		}
+	}
+	return 0
+}
+
+func hasPrefixWords(words, prefix []string) bool {
+	if len(words) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if words[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Return true if the text is a license, exception, or license reference accepted by the strict parser.
+func isStrictLicense(text string) bool {
+	tokens, err := scan(text, nil)
+	if err != nil || len(tokens) == 0 {
+		return false
+	}
+	for _, token := range tokens {
+		if token.role == operatorToken && token.value != "+" && token.value != ":" {
+			return false
+		}
+	}
+	return true
+}
+
+// Return the SPDX id for the words of a license name, the confidence of the rewrite, and the
+// reasons for it.  Returns "" if the words do not name a known license.
+func lenientLicense(lexemes []lexeme, orLater bool) (string, Confidence, []string) {
+	reasons := []string{reasonLicenseName}
+	confidence := HighConfidence
+	if orLater {
+		reasons = append(reasons, reasonOrLater)
+	}
+
+	var words []string
+	for _, lex := range lexemes {
+		word := strings.ToLower(lex.text)
+		if word == "," || lenientFillers[word] {
+			continue
+		}
+		words = append(words, word)
+	}
+	if orLater {
+		// drop the "or later" phrase
+		for len(words) > 0 && words[len(words)-1] != "or" {
+			words = words[:len(words)-1]
+		}
+		if len(words) > 0 {
+			words = words[:len(words)-1]
+		}
+	}
+	if len(words) == 0 {
+		return "", confidence, nil
+	}
+
+	plus := orLater
+	last := words[len(words)-1]
+	if strings.HasSuffix(last, "+") {
+		plus = true
+		words[len(words)-1] = strings.TrimSuffix(last, "+")
+	}
+	words = replacePhrases(words)
+
+	if alias, ok := lenientAliases[strings.Join(words, " ")]; ok {
+		reasons[0] = reasonAlias
+		id := alias.id
+		if plus {
+			id += "+"
+		}
+		return id, alias.confidence, reasons
+	}
+
+	id, adjustments := lenientVersionedLicense(strings.Join(words, "-"), plus)
+	if id == "" {
+		return "", confidence, nil
+	}
+	for _, adjustment := range adjustments {
+		if adjustment != reasonAddedMinor {
+			// a missing minor version is always 0, but other assumptions may be wrong
+			confidence = MediumConfidence
+		}
+	}
+	return id, confidence, append(reasons, adjustments...)
+}
+
+// Replace multi-word names with their abbreviations.
+func replacePhrases(words []string) []string {
+	var replaced []string
+	for i := 0; i < len(words); i++ {
+		matched := false
+		for _, phrase := range lenientPhrases {
+			if hasPrefixWords(words[i:], phrase.words) {
+				replaced = append(replaced, phrase.abbr)
+				i += len(phrase.words) - 1
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			replaced = append(replaced, words[i])
+		}
+	}
+	return replaced
+}
+
+// Find the SPDX id for a lowercase license name with an optional version (e.g. "gplv2",
+// "apache-2", "bsd-3-clause").  Returns the id and the assumptions made to find it, or "" if the
+// name is not a known license.
+func lenientVersionedLicense(name string, plus bool) (string, []string) {
+	name = strings.ReplaceAll(name, "_", "-")
+	onlySuffix := false
+	if strings.HasSuffix(name, "-only") {
+		name = strings.TrimSuffix(name, "-only")
+		onlySuffix = true
+	} else if strings.HasSuffix(name, "-or-later") {
+		name = strings.TrimSuffix(name, "-or-later")
+		plus = true
+	}
+
+	type candidate struct {
+		base        string
+		adjustments []string
+	}
+	candidates := []candidate{{base: name}}
+	if m := lenientVersion.FindStringSubmatch(name); m != nil {
+		base := strings.TrimSuffix(m[1], "-") + "-" + m[2]
+		candidates = []candidate{{base: base}}
+		if !strings.Contains(m[2], ".") {
+			candidates = append(candidates,
+				candidate{base: base + ".0", adjustments: []string{reasonAddedMinor}},
+				candidate{base: base + "-clause", adjustments: []string{reasonAddedClause}})
+		}
+		candidates = append(candidates, candidate{base: name})
+	}
+
+	for _, c := range candidates {
+		if plus {
+			if ok, id := activeLicense(c.base + "-or-later"); ok {
+				return id, c.adjustments
+			}
+			if ok, id := activeLicense(c.base); ok {
+				return id + "+", c.adjustments
+			}
+			if ok, id := deprecatedLicense(c.base); ok {
+				return id + "+", c.adjustments
+			}
+			continue
+		}
+		if ok, id := activeLicense(c.base + "-only"); ok {
+			if onlySuffix {
+				return id, c.adjustments
+			}
+			return id, append(c.adjustments, reasonAssumedOnly)
+		}
+		if ok, id := activeLicense(c.base); ok {
+			return id, c.adjustments
+		}
+		if ok, id := deprecatedLicense(c.base); ok {
+			return id, c.adjustments
+		}
+	}
+	return "", nil
+}
+
+// Add a piece of the rewritten expression that is unchanged from the source text.
+func (l *lenientParser) emit(text string, sourceStart, sourceEnd int) {
+	l.pieces = append(l.pieces, piece{text: text, sourceStart: sourceStart, sourceEnd: sourceEnd})
+}
+
+// Add a piece of the rewritten expression that replaces the source text and record the rewrite.
+func (l *lenientParser) replace(text string, sourceStart, sourceEnd int, reason string, confidence Confidence) {
+	l.emit(text, sourceStart, sourceEnd)
+	l.rewrites = append(l.rewrites, Rewrite{
+		Original:    l.source[sourceStart:sourceEnd],
+		Replacement: text,
+		Span:        Span{Start: sourceStart, End: sourceEnd},
+		Reason:      reason,
+		Confidence:  confidence,
+	})
+}
+
+// Return the rewritten expression, recording the offset of each piece in it.
+func (l *lenientParser) output() string {
+	var sb strings.Builder
+	for i := range l.pieces {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		l.pieces[i].start = sb.Len()
+		sb.WriteString(l.pieces[i].text)
+		l.pieces[i].end = sb.Len()
+	}
+	return sb.String()
+}
+
+// Map an offset in the rewritten expression to an offset in the source expression.
+func (l *lenientParser) toSource(offset int) int {
+	for _, p := range l.pieces {
+		if offset == p.end {
+			return p.sourceEnd
+		}
+		if offset >= p.start && offset < p.end {
+			if p.text == l.source[p.sourceStart:p.sourceEnd] {
+				return p.sourceStart + offset - p.start
+			}
+			return p.sourceStart
+		}
+	}
+	return len(l.source)
+}
@@ -0,0 +1,122 @@
+package spdxexp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLenient(t *testing.T) {
+	tests := []struct {
+		name         string
+		expression   string
+		normalized   string
+		confidence   Confidence
+		replacements []string // "Original -> Replacement" for each rewrite
+	}{
+		{"valid SPDX is unchanged", "MIT OR Apache-2.0", "MIT OR Apache-2.0", HighConfidence, nil},
+		{"lowercase id is unchanged", "apache-2.0", "Apache-2.0", HighConfidence, nil},
+		{"lowercase operators are unchanged", "mit or apache-2.0", "MIT OR Apache-2.0", HighConfidence, nil},
+		{"lowercase with is unchanged", "GPL-2.0-or-later with Classpath-exception-2.0", "GPL-2.0-or-later WITH Classpath-exception-2.0", HighConfidence, nil},
+		{"mixed case operators", "MIT Or Apache-2.0 AnD ISC", "MIT OR Apache-2.0 AND ISC", HighConfidence,
+			[]string{"Or -> OR", "AnD -> AND"}},
+		{"slash", "MIT/Apache-2.0", "MIT OR Apache-2.0", MediumConfidence,
+			[]string{"/ -> OR"}},
+		{"comma", "MIT, Apache-2.0", "MIT AND Apache-2.0", LowConfidence,
+			[]string{", -> AND"}},
+		{"GPLv2+", "GPLv2+", "GPL-2.0-or-later", HighConfidence,
+			[]string{"GPLv2+ -> GPL-2.0-or-later"}},
+		{"GPLv2 assumed only", "GPLv2", "GPL-2.0-only", MediumConfidence,
+			[]string{"GPLv2 -> GPL-2.0-only"}},
+		{"LGPLv2.1", "LGPLv2.1", "LGPL-2.1-only", MediumConfidence,
+			[]string{"LGPLv2.1 -> LGPL-2.1-only"}},
+		{"name and version", "Apache 2.0", "Apache-2.0", HighConfidence,
+			[]string{"Apache 2.0 -> Apache-2.0"}},
+		{"missing minor version", "Apache-2", "Apache-2.0", HighConfidence,
+			[]string{"Apache-2 -> Apache-2.0"}},
+		{"BSD clause count", "BSD-3", "BSD-3-Clause", MediumConfidence,
+			[]string{"BSD-3 -> BSD-3-Clause"}},
+		{"filler words", "The MIT License", "MIT", HighConfidence,
+			[]string{"The MIT License -> MIT"}},
+		{"comma in name", "Apache License, Version 2.0", "Apache-2.0", HighConfidence,
+			[]string{"Apache License, Version 2.0 -> Apache-2.0"}},
+		{"spelled out name or later", "GNU General Public License v3 or later", "GPL-3.0-or-later", HighConfidence,
+			[]string{"GNU General Public License v3 or later -> GPL-3.0-or-later"}},
+		{"or any later version", "GPL v2 or any later version OR MIT", "GPL-2.0-or-later OR MIT", HighConfidence,
+			[]string{"GPL v2 or any later version -> GPL-2.0-or-later"}},
+		{"alias", "Expat", "MIT", MediumConfidence,
+			[]string{"Expat -> MIT"}},
+		{"mixed", "(mit/bsd-3) and GPLv3+", "(MIT OR BSD-3-Clause) AND GPL-3.0-or-later", MediumConfidence,
+			[]string{"/ -> OR", "bsd-3 -> BSD-3-Clause", "GPLv3+ -> GPL-3.0-or-later"}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			result, err := ParseLenient(test.expression)
+			require.NoError(t, err)
+			assert.Equal(t, test.normalized, result.Expression.String())
+			assert.Equal(t, test.confidence, result.Confidence)
+
+			var replacements []string
+			for _, rewrite := range result.Rewrites {
+				replacements = append(replacements, rewrite.Original+" -> "+rewrite.Replacement)
+				assert.Equal(t, rewrite.Original, test.expression[rewrite.Span.Start:rewrite.Span.End])
+				assert.NotEmpty(t, rewrite.Reason)
+			}
+			assert.Equal(t, test.replacements, replacements)
+		})
+	}
+}
+
+func TestParseLenientSpans(t *testing.T) {
+	source := "(mit/bsd-3) and The Apache License, Version 2.0"
+	result, err := ParseLenient(source)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"(mit/bsd-3) and The Apache License, Version 2.0",
+		"mit/bsd-3",
+		"mit",
+		"bsd-3",
+		"The Apache License, Version 2.0",
+	}, spanTexts(t, source, result.Expression))
+}
+
+func TestParseLenientError(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		kind       ParseErrorKind
+		offset     int
+		msg        string
+	}{
+		{"empty expression", "", EmptyExpression, 0, "parse error - cannot parse empty string"},
+		{"unknown license", "The MIT License/FOO", UnknownLicense, 16, "unknown license 'FOO' at offset 16"},
+		{"missing operand", "mit and", MissingOperand, 7, "expected expression following AND, but found none"},
+		{"only open paren", "(", MissingOperand, 1, "expected license or expression, but found none"},
+		{"open paren at end", "MIT AND (", MissingOperand, 9, "expected license or expression, but found none"},
+		{"open paren after slash", "The MIT License / (", MissingOperand, 19, "expected license or expression, but found none"},
+		{"document ref at end", "MIT, DocumentRef-x", MalformedReference, 18, "expected ':' after 'DocumentRef-...'"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			result, err := ParseLenient(test.expression)
+			assert.Nil(t, result)
+			var parseErr *ParseError
+			require.True(t, errors.As(err, &parseErr))
+			assert.Equal(t, test.kind, parseErr.Kind)
+			assert.Equal(t, test.offset, parseErr.Offset)
+			assert.EqualError(t, err, test.msg)
+		})
+	}
+}
+
+func TestConfidenceString(t *testing.T) {
+	assert.Equal(t, "Low", LowConfidence.String())
+	assert.Equal(t, "Medium", MediumConfidence.String())
+	assert.Equal(t, "High", HighConfidence.String())
+}
@@ -2,6 +2,7 @@ package spdxexp
 
 import (
 	"strings"
+	"sync"
 
 	"github.com/github/go-spdx/v2/spdxexp/spdxlicenses"
 )
@@ -26,6 +27,11 @@ func exceptionLicense(id string) (bool, string) {
 	return spdxlicenses.IsException(id)
 }
 
+// deprecatedException returns true if the id is a deprecated exception license.
+func deprecatedException(id string) (bool, string) {
+	return spdxlicenses.IsDeprecatedException(id)
+}
+
 const (
 	licenseGroup uint8 = iota
 	versionGroup
@@ -37,28 +43,58 @@ type licenseRange struct {
 	location map[uint8]int // licenseGroup, versionGroup, licenseIndex
 }
 
-// getLicenseRange returns a range of licenses from licenseRanges
-func getLicenseRange(id string) *licenseRange {
-	simpleID := simplifyLicense(id)
-	allRanges := spdxlicenses.LicenseRanges()
-	for i, licenseGrp := range allRanges {
-		for j, versionGrp := range licenseGrp {
-			for k, license := range versionGrp {
-				if simpleID == license {
-					location := map[uint8]int{
-						licenseGroup: i,
-						versionGroup: j,
-						licenseIndex: k,
+var (
+	licenseRangesOnce  sync.Once
+	licenseRanges      [][][]string
+	licenseRangesIndex map[string]*licenseRange
+)
+
+// loadLicenseRanges loads and indexes the license ranges by license the first time they are
+// needed, since searching every range for each comparison of licenses is expensive.
+func loadLicenseRanges() {
+	licenseRangesOnce.Do(func() {
+		licenseRanges = spdxlicenses.LicenseRanges()
+		licenseRangesIndex = map[string]*licenseRange{}
+		for i, licenseGrp := range licenseRanges {
+			for j, versionGrp := range licenseGrp {
+				for k, license := range versionGrp {
+					if _, ok := licenseRangesIndex[license]; ok {
+						// keep the first range of a license that is in more than one range
+						continue
 					}
-					return &licenseRange{
+					licenseRangesIndex[license] = &licenseRange{
 						licenses: versionGrp,
-						location: location,
+						location: map[uint8]int{
+							licenseGroup: i,
+							versionGroup: j,
+							licenseIndex: k,
+						},
 					}
 				}
 			}
 		}
+	})
+}
+
+// getLicenseRange returns a range of licenses from licenseRanges
+func getLicenseRange(id string) *licenseRange {
+	loadLicenseRanges()
+	return licenseRangesIndex[simplifyLicense(id)]
+}
+
+// laterLicenses returns the licenses in the same license group as id that are the same version or
+// a later version (e.g. "GPL-2.0-only", "GPL-3.0", and "GPL-3.0-only" for "GPL-2.0").  Returns nil
+// if id is not in a range.
+func laterLicenses(id string) []string {
+	r := getLicenseRange(id)
+	if r == nil {
+		return nil
+	}
+	var later []string
+	for _, versionGrp := range licenseRanges[r.location[licenseGroup]][r.location[versionGroup]:] {
+		later = append(later, versionGrp...)
 	}
-	return nil
+	return later
 }
 
 func simplifyLicense(id string) string {
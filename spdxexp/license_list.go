@@ -0,0 +1,355 @@
+package spdxexp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/github/go-spdx/v2/spdxexp/spdxlicenses"
+)
+
+// LicenseList is a version of the SPDX license list, which determines the license and exception
+// ids that are valid.  The list embedded in the spdxlicenses package is used by default.  Use
+// LoadLicenseList or ReadLicenseList to use a different version of the list, and pass it in the
+// LicenseList field of ParseOptions, ValidateLicensesOptions, SatisfiesOptions,
+// AllowListOptions, PolicyLists, NormalFormOptions, or ExtractLicensesOptions.  Use LicenseLists to
+// choose the list by version, e.g. the version an SBOM declares.  Choose, ChooseWithCost,
+// Equivalent, Implies, Simplify, Modernize, and ParseLenient always use the embedded list.
+//
+// Only the ids are loaded.  License ranges (e.g. "Apache-2.0" is a later version of "Apache-1.0"),
+// license metadata, and the replacements used by Modernize are always from the embedded list.  A
+// LicenseList is not changed after it is loaded, so it is safe for concurrent use.
+type LicenseList struct {
+	version              string
+	licenses             idTable
+	deprecated           idTable
+	exceptions           idTable
+	deprecatedExceptions idTable
+}
+
+// idTable is a sorted list of ids indexed by uppercase id.
+type idTable struct {
+	ids     []string
+	byUpper map[string]string
+}
+
+func newIDTable(byUpper map[string]string) idTable {
+	ids := make([]string, 0, len(byUpper))
+	for _, id := range byUpper {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return idTable{ids: ids, byUpper: byUpper}
+}
+
+// lookup does a case-insensitive lookup for the id.  It returns true and the case-sensitive id if
+// found, otherwise false and the original id.
+func (t idTable) lookup(id string) (bool, string) {
+	if foundID, ok := t.byUpper[strings.ToUpper(id)]; ok {
+		return true, foundID
+	}
+	return false, id
+}
+
+var (
+	defaultLicenseListOnce sync.Once
+	defaultLicenseList     *LicenseList
+)
+
+// DefaultLicenseList returns the license list embedded in the spdxlicenses package.
+func DefaultLicenseList() *LicenseList {
+	defaultLicenseListOnce.Do(func() {
+		defaultLicenseList = &LicenseList{
+			version:              spdxlicenses.Version(),
+			licenses:             newIDTable(spdxlicenses.GetLicensesMap()),
+			deprecated:           newIDTable(spdxlicenses.GetDeprecatedMap()),
+			exceptions:           newIDTable(spdxlicenses.GetExceptionsMap()),
+			deprecatedExceptions: newIDTable(spdxlicenses.GetDeprecatedExceptionsMap()),
+		}
+	})
+	return defaultLicenseList
+}
+
+// LoadLicenseList loads a license list from the files licenses.json and exceptions.json in fsys.
+// The files have the JSON format of the SPDX license list data
+// (https://github.com/spdx/license-list-data/tree/main/json).
+// Returns error if either file cannot be read or is not a valid license list.
+func LoadLicenseList(fsys fs.FS) (*LicenseList, error) {
+	licenses, err := fsys.Open("licenses.json")
+	if err != nil {
+		return nil, err
+	}
+	defer licenses.Close()
+
+	exceptions, err := fsys.Open("exceptions.json")
+	if err != nil {
+		return nil, err
+	}
+	defer exceptions.Close()
+
+	return ReadLicenseList(licenses, exceptions)
+}
+
+// ReadLicenseList reads a license list from the contents of licenses.json and exceptions.json in
+// the JSON format of the SPDX license list data.  See LoadLicenseList.
+// Returns error if either reader cannot be read or is not a valid license list, or if their
+// licenseListVersion is different.
+func ReadLicenseList(licenses, exceptions io.Reader) (*LicenseList, error) {
+	var licenseData struct {
+		Version  string `json:"licenseListVersion"`
+		Licenses []struct {
+			LicenseID    string `json:"licenseId"`
+			IsDeprecated bool   `json:"isDeprecatedLicenseId"`
+		} `json:"licenses"`
+	}
+	if err := json.NewDecoder(licenses).Decode(&licenseData); err != nil {
+		return nil, fmt.Errorf("read licenses: %w", err)
+	}
+	if len(licenseData.Licenses) == 0 {
+		return nil, errors.New("read licenses: no licenses found")
+	}
+
+	var exceptionData struct {
+		Version    string `json:"licenseListVersion"`
+		Exceptions []struct {
+			LicenseExceptionID string `json:"licenseExceptionId"`
+			IsDeprecated       bool   `json:"isDeprecatedLicenseId"`
+		} `json:"exceptions"`
+	}
+	if err := json.NewDecoder(exceptions).Decode(&exceptionData); err != nil {
+		return nil, fmt.Errorf("read exceptions: %w", err)
+	}
+	if exceptionData.Version != "" && exceptionData.Version != licenseData.Version {
+		return nil, fmt.Errorf("read exceptions: license list version '%s' does not match licenses version '%s'",
+			exceptionData.Version, licenseData.Version)
+	}
+
+	active, deprecated := map[string]string{}, map[string]string{}
+	for _, l := range licenseData.Licenses {
+		if !isID(l.LicenseID) && !(strings.HasSuffix(l.LicenseID, "+") && isID(strings.TrimSuffix(l.LicenseID, "+"))) {
+			// deprecated ids can end with + (e.g. "GPL-2.0+")
+			return nil, fmt.Errorf("read licenses: invalid license id '%s'", l.LicenseID)
+		}
+		if l.IsDeprecated {
+			deprecated[strings.ToUpper(l.LicenseID)] = l.LicenseID
+		} else {
+			active[strings.ToUpper(l.LicenseID)] = l.LicenseID
+		}
+	}
+
+	exceptionIDs, deprecatedExceptionIDs := map[string]string{}, map[string]string{}
+	for _, e := range exceptionData.Exceptions {
+		if !isID(e.LicenseExceptionID) {
+			return nil, fmt.Errorf("read exceptions: invalid exception id '%s'", e.LicenseExceptionID)
+		}
+		if e.IsDeprecated {
+			deprecatedExceptionIDs[strings.ToUpper(e.LicenseExceptionID)] = e.LicenseExceptionID
+		} else {
+			exceptionIDs[strings.ToUpper(e.LicenseExceptionID)] = e.LicenseExceptionID
+		}
+	}
+
+	return &LicenseList{
+		version:              licenseData.Version,
+		licenses:             newIDTable(active),
+		deprecated:           newIDTable(deprecated),
+		exceptions:           newIDTable(exceptionIDs),
+		deprecatedExceptions: newIDTable(deprecatedExceptionIDs),
+	}, nil
+}
+
+// Version returns the licenseListVersion of the list, e.g. "3.19".  It is empty if the list was
+// read from JSON without a licenseListVersion.
+func (l *LicenseList) Version() string {
+	return l.orDefault().version
+}
+
+// orDefault returns the list, or the default license list if the list is nil, so that a nil
+// *LicenseList can be used for the default list.
+func (l *LicenseList) orDefault() *LicenseList {
+	if l == nil {
+		return DefaultLicenseList()
+	}
+	return l
+}
+
+// activeLicense returns true if the id is an active license in the list.
+func (l *LicenseList) activeLicense(id string) (bool, string) {
+	return l.orDefault().licenses.lookup(id)
+}
+
+// deprecatedLicense returns true if the id is a deprecated license in the list.
+func (l *LicenseList) deprecatedLicense(id string) (bool, string) {
+	return l.orDefault().deprecated.lookup(id)
+}
+
+// exceptionLicense returns true if the id is an exception license in the list.
+func (l *LicenseList) exceptionLicense(id string) (bool, string) {
+	return l.orDefault().exceptions.lookup(id)
+}
+
+// deprecatedException returns true if the id is a deprecated exception license in the list.
+func (l *LicenseList) deprecatedException(id string) (bool, string) {
+	return l.orDefault().deprecatedExceptions.lookup(id)
+}
+
+// known returns true if the id is a license or exception in the list.
+func (l *LicenseList) known(id string) bool {
+	for _, lookup := range []func(string) (bool, string){
+		l.activeLicense, l.deprecatedLicense, l.exceptionLicense, l.deprecatedException,
+	} {
+		if ok, _ := lookup(id); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Licenses returns the active license ids in the list, sorted.
+func (l *LicenseList) Licenses() []string {
+	return append([]string(nil), l.orDefault().licenses.ids...)
+}
+
+// Deprecated returns the deprecated license ids in the list, sorted.
+func (l *LicenseList) Deprecated() []string {
+	return append([]string(nil), l.orDefault().deprecated.ids...)
+}
+
+// Exceptions returns the active exception ids in the list, sorted.
+func (l *LicenseList) Exceptions() []string {
+	return append([]string(nil), l.orDefault().exceptions.ids...)
+}
+
+// DeprecatedExceptions returns the deprecated exception ids in the list, sorted.
+func (l *LicenseList) DeprecatedExceptions() []string {
+	return append([]string(nil), l.orDefault().deprecatedExceptions.ids...)
+}
+
+// ErrUnknownLicenseListVersion is returned by LicenseLists.Get when there is no list for the
+// version.
+var ErrUnknownLicenseListVersion = errors.New("unknown license list version")
+
+// LicenseLists is a set of license lists indexed by version, so that an expression can be
+// validated against the version of the SPDX license list that it was written for.  Only the
+// embedded list is bundled, so other versions are added with NewLicenseLists or LoadLicenseLists.
+//
+// Example:
+//
+//	lists, err := LoadLicenseLists(os.DirFS("license-lists"))
+//	list, err := lists.Get("3.19")
+//	valid, invalid := ValidateLicensesWithOptions(licenses, ValidateLicensesOptions{LicenseList: list})
+type LicenseLists struct {
+	byVersion map[string]*LicenseList
+}
+
+// NewLicenseLists returns a set of the default license list and the given lists.  A list replaces
+// an earlier list with the same version, including the default list.
+// Returns error if a list does not have a version.
+func NewLicenseLists(lists ...*LicenseList) (*LicenseLists, error) {
+	defaultList := DefaultLicenseList()
+	s := &LicenseLists{byVersion: map[string]*LicenseList{normalizeListVersion(defaultList.version): defaultList}}
+	for _, list := range lists {
+		if list == nil {
+			continue
+		}
+		if list.version == "" {
+			return nil, errors.New("license list does not have a licenseListVersion")
+		}
+		s.byVersion[normalizeListVersion(list.version)] = list
+	}
+	return s, nil
+}
+
+// LoadLicenseLists loads a license list from each directory in fsys that has a licenses.json file,
+// as by LoadLicenseList, and returns a set of the default license list and the loaded lists.  Each
+// list is indexed by the licenseListVersion in its files, so the directory names do not matter,
+// e.g. "3.19/json" and "3.20/json" can be copied from the tags of the SPDX license list data.
+// Returns error if a list cannot be loaded or does not have a version.
+func LoadLicenseLists(fsys fs.FS) (*LicenseLists, error) {
+	var lists []*LicenseList
+	err := fs.WalkDir(fsys, ".", func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || d.Name() != "licenses.json" {
+			return nil
+		}
+		dir := path.Dir(filePath)
+		sub, err := fs.Sub(fsys, dir)
+		if err != nil {
+			return err
+		}
+		list, err := LoadLicenseList(sub)
+		if err != nil {
+			return fmt.Errorf("load license list '%s': %w", dir, err)
+		}
+		if list.version == "" {
+			return fmt.Errorf("load license list '%s': license list does not have a licenseListVersion", dir)
+		}
+		lists = append(lists, list)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return NewLicenseLists(lists...)
+}
+
+// Get returns the license list for the version.  A leading "v" is ignored, so "v3.19" is the
+// same as "3.19".
+// Returns an error wrapping ErrUnknownLicenseListVersion if there is no list for the version.
+func (s *LicenseLists) Get(version string) (*LicenseList, error) {
+	list, ok := s.byVersion[normalizeListVersion(version)]
+	if !ok {
+		return nil, fmt.Errorf("%w '%s'", ErrUnknownLicenseListVersion, version)
+	}
+	return list, nil
+}
+
+// Versions returns the versions of the license lists in the set, sorted from oldest to newest.
+func (s *LicenseLists) Versions() []string {
+	versions := make([]string, 0, len(s.byVersion))
+	for _, list := range s.byVersion {
+		versions = append(versions, list.version)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return compareListVersions(versions[i], versions[j]) < 0
+	})
+	return versions
+}
+
+// normalizeListVersion removes whitespace and a leading "v" from a license list version.
+func normalizeListVersion(version string) string {
+	version = strings.TrimSpace(version)
+	if strings.HasPrefix(version, "v") || strings.HasPrefix(version, "V") {
+		version = version[1:]
+	}
+	return version
+}
+
+// compareListVersions compares license list versions by their dot-separated parts, comparing
+// numeric parts as numbers (e.g. "3.9" is before "3.19") and other parts as strings.
+func compareListVersions(a, b string) int {
+	aParts := strings.Split(normalizeListVersion(a), ".")
+	bParts := strings.Split(normalizeListVersion(b), ".")
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		aNum, aErr := strconv.Atoi(aParts[i])
+		bNum, bErr := strconv.Atoi(bParts[i])
+		switch {
+		case aErr == nil && bErr == nil:
+			if aNum != bNum {
+				return aNum - bNum
+			}
+		case aParts[i] != bParts[i]:
+			return strings.Compare(aParts[i], bParts[i])
+		}
+	}
+	return len(aParts) - len(bParts)
+}
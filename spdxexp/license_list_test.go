@@ -0,0 +1,328 @@
+package spdxexp
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/github/go-spdx/v2/spdxexp/spdxlicenses"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testLicensesJSON = `{
+  "licenseListVersion": "test",
+  "licenses": [
+    {"licenseId": "MIT", "isDeprecatedLicenseId": false},
+    {"licenseId": "Future-1.0", "isDeprecatedLicenseId": false},
+    {"licenseId": "GPL-2.0-only", "isDeprecatedLicenseId": false},
+    {"licenseId": "GPL-2.0", "isDeprecatedLicenseId": true},
+    {"licenseId": "GPL-2.0+", "isDeprecatedLicenseId": true}
+  ]
+}`
+
+const testExceptionsJSON = `{
+  "licenseListVersion": "test",
+  "exceptions": [
+    {"licenseExceptionId": "Future-exception", "isDeprecatedLicenseId": false},
+    {"licenseExceptionId": "Old-exception", "isDeprecatedLicenseId": true}
+  ]
+}`
+
+func testLicenseList(t *testing.T) *LicenseList {
+	licenses, err := LoadLicenseList(fstest.MapFS{
+		"licenses.json":   {Data: []byte(testLicensesJSON)},
+		"exceptions.json": {Data: []byte(testExceptionsJSON)},
+	})
+	require.NoError(t, err)
+	return licenses
+}
+
+func TestLoadLicenseList(t *testing.T) {
+	licenses := testLicenseList(t)
+	assert.Equal(t, "test", licenses.Version())
+	assert.Equal(t, []string{"Future-1.0", "GPL-2.0-only", "MIT"}, licenses.Licenses())
+	assert.Equal(t, []string{"GPL-2.0", "GPL-2.0+"}, licenses.Deprecated())
+	assert.Equal(t, []string{"Future-exception"}, licenses.Exceptions())
+	assert.Equal(t, []string{"Old-exception"}, licenses.DeprecatedExceptions())
+
+	ok, id := licenses.activeLicense("future-1.0")
+	assert.True(t, ok)
+	assert.Equal(t, "Future-1.0", id)
+	ok, _ = licenses.activeLicense("Apache-2.0")
+	assert.False(t, ok)
+}
+
+func TestLoadLicenseListMatchesDefault(t *testing.T) {
+	// the SPDX license list data that the spdxlicenses package is generated from
+	licenses, err := LoadLicenseList(os.DirFS("../cmd"))
+	require.NoError(t, err)
+
+	defaultList := DefaultLicenseList()
+	assert.Equal(t, spdxlicenses.Version(), defaultList.Version())
+	assert.Equal(t, defaultList.Version(), licenses.Version())
+	assert.Equal(t, defaultList.Licenses(), licenses.Licenses())
+	assert.Equal(t, defaultList.Deprecated(), licenses.Deprecated())
+	assert.Equal(t, defaultList.Exceptions(), licenses.Exceptions())
+	assert.Equal(t, defaultList.DeprecatedExceptions(), licenses.DeprecatedExceptions())
+	assert.Equal(t, len(spdxlicenses.GetLicenses()), len(defaultList.Licenses()))
+}
+
+func TestLoadLicenseListError(t *testing.T) {
+	tests := []struct {
+		name       string
+		licenses   string
+		exceptions string
+		err        string
+	}{
+		{"invalid licenses JSON", `{"licenses": [`, testExceptionsJSON, "read licenses: unexpected EOF"},
+		{"no licenses", `{"licenses": []}`, testExceptionsJSON, "read licenses: no licenses found"},
+		{"invalid license id", `{"licenses": [{"licenseId": "MIT License"}]}`, `{"exceptions": []}`,
+			"read licenses: invalid license id 'MIT License'"},
+		{"invalid exceptions JSON", testLicensesJSON, `{"exceptions": [`, "read exceptions: unexpected EOF"},
+		{"different versions", testLicensesJSON, `{"licenseListVersion": "3.19", "exceptions": []}`,
+			"read exceptions: license list version '3.19' does not match licenses version 'test'"},
+		{"invalid exception id", testLicensesJSON, `{"exceptions": [{"licenseExceptionId": ""}]}`,
+			"read exceptions: invalid exception id ''"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			licenses, err := ReadLicenseList(strings.NewReader(test.licenses), strings.NewReader(test.exceptions))
+			assert.Nil(t, licenses)
+			assert.EqualError(t, err, test.err)
+		})
+	}
+
+	// files are required
+	_, err := LoadLicenseList(fstest.MapFS{"licenses.json": {Data: []byte(testLicensesJSON)}})
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+}
+
+func TestParseWithLicenseList(t *testing.T) {
+	options := ParseOptions{LicenseList: testLicenseList(t)}
+
+	expression, err := ParseWithOptions("future-1.0 WITH old-exception OR GPL-2.0+", options)
+	require.NoError(t, err)
+	// GPL-2.0-or-later is not in the list, so GPL-2.0+ is not replaced
+	assert.Equal(t, "Future-1.0 WITH Old-exception OR GPL-2.0+", expression.String())
+
+	// licenses that are not in the list are unknown, and only licenses in the list are suggested
+	_, err = ParseWithOptions("MIT AND Apache-2.0", options)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, UnknownLicense, parseErr.Kind)
+	assert.Empty(t, parseErr.Suggestions())
+
+	_, err = ParseWithOptions("Future-1", options)
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, []string{"Future-1.0"}, parseErr.Suggestions())
+
+	// recovering uses the list too
+	_, err = ParseWithOptions("MIT AND Apache-2.0 AND Future-1.0", ParseOptions{Recover: true, LicenseList: options.LicenseList})
+	var parseErrs ParseErrors
+	require.True(t, errors.As(err, &parseErrs))
+	assert.Len(t, parseErrs, 1)
+
+	// the default list does not have the new license
+	_, err = Parse("Future-1.0")
+	assert.Error(t, err)
+}
+
+func TestValidateLicensesWithLicenseList(t *testing.T) {
+	options := ValidateLicensesOptions{LicenseList: testLicenseList(t)}
+	normalized, invalid := ValidateAndNormalizeLicensesWithOptions([]string{
+		"MIT", "future-1.0", "Apache-2.0", "GPL-2.0 WITH Future-exception", "MIT AND Future-1.0", "MIT AND ISC",
+	}, options)
+	assert.Equal(t, []string{"MIT", "Future-1.0", "GPL-2.0 WITH Future-exception", "MIT AND Future-1.0"}, normalized)
+	assert.Equal(t, []string{"Apache-2.0", "MIT AND ISC"}, invalid)
+}
+
+func TestSatisfiesWithLicenseList(t *testing.T) {
+	options := SatisfiesOptions{LicenseList: testLicenseList(t)}
+
+	satisfied, err := SatisfiesWithOptions("Future-1.0 OR Apache-2.0", []string{"Future-1.0", "MIT"}, options)
+	assert.EqualError(t, err, "unknown license 'Apache-2.0' at offset 14")
+	assert.False(t, satisfied)
+
+	satisfied, err = SatisfiesWithOptions("Future-1.0 OR GPL-2.0", []string{"Future-1.0", "MIT"}, options)
+	require.NoError(t, err)
+	assert.True(t, satisfied)
+
+	satisfied, err = SatisfiesWithOptions("MIT", []string{"Future-*"}, options)
+	require.NoError(t, err)
+	assert.False(t, satisfied)
+
+	_, err = SatisfiesWithOptions("MIT", []string{"ISC"}, options)
+	assert.EqualError(t, err, "invalid allowedList entry \"ISC\" at index 0: unknown license 'ISC' at offset 0")
+
+	result, err := SatisfiesDetailed("Future-1.0", []string{"Future-1.0"}, options)
+	require.NoError(t, err)
+	assert.True(t, result.Satisfied)
+}
+
+func TestCompileAllowListWithLicenseList(t *testing.T) {
+	licenses := testLicenseList(t)
+	allowed, err := CompileAllowListWithOptions([]string{"Future-1.0", "MIT AND GPL-2.0-only"}, AllowListOptions{LicenseList: licenses})
+	require.NoError(t, err)
+
+	// test expressions are parsed with the list that the allowed list was compiled with
+	satisfied, err := allowed.Satisfies("Future-1.0 OR Apache-2.0")
+	assert.EqualError(t, err, "unknown license 'Apache-2.0' at offset 14")
+	assert.False(t, satisfied)
+
+	satisfied, err = allowed.Satisfies("GPL-2.0-only AND MIT")
+	require.NoError(t, err)
+	assert.True(t, satisfied)
+
+	result, err := allowed.SatisfiesDetailed("Future-1.0", SatisfiesOptions{})
+	require.NoError(t, err)
+	assert.True(t, result.Satisfied)
+
+	// unless the options have a list
+	_, err = allowed.SatisfiesWithOptions("Future-1.0", SatisfiesOptions{LicenseList: DefaultLicenseList()})
+	assert.EqualError(t, err, "unknown license 'Future-1.0' at offset 0")
+
+	_, err = CompileAllowListWithOptions([]string{"ISC"}, AllowListOptions{LicenseList: licenses})
+	assert.EqualError(t, err, "invalid allowedList entry \"ISC\" at index 0: unknown license 'ISC' at offset 0")
+}
+
+func TestCompilePolicyWithLicenseList(t *testing.T) {
+	policy, err := CompilePolicy(PolicyLists{
+		Allowed:     []string{"Future-1.0"},
+		Denied:      []string{"GPL-2.0-only"},
+		LicenseList: testLicenseList(t),
+	})
+	require.NoError(t, err)
+
+	verdict, err := policy.Evaluate("Future-1.0 OR GPL-2.0-only")
+	require.NoError(t, err)
+	assert.Equal(t, Allowed, verdict)
+
+	_, err = policy.Evaluate("Apache-2.0")
+	assert.EqualError(t, err, "unknown license 'Apache-2.0' at offset 0")
+
+	_, err = CompilePolicy(PolicyLists{Review: []string{"ISC"}, LicenseList: testLicenseList(t)})
+	assert.EqualError(t, err, "invalid review entry \"ISC\" at index 0: unknown license 'ISC' at offset 0")
+}
+
+func TestNormalFormWithLicenseList(t *testing.T) {
+	options := NormalFormOptions{LicenseList: testLicenseList(t)}
+
+	dnf, err := ToDNF("MIT AND (Future-1.0 OR GPL-2.0-only)", options)
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"Future-1.0", "MIT"}, {"GPL-2.0-only", "MIT"}}, dnf)
+
+	_, err = ToCNF("MIT OR Apache-2.0", options)
+	assert.EqualError(t, err, "unknown license 'Apache-2.0' at offset 7")
+}
+
+func TestExtractLicensesWithLicenseList(t *testing.T) {
+	options := ExtractLicensesOptions{LicenseList: testLicenseList(t)}
+
+	licenses, err := ExtractLicensesWithOptions("MIT AND Future-1.0 WITH Future-exception", options)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"MIT", "Future-1.0 WITH Future-exception"}, licenses)
+
+	_, err = ExtractLicensesWithOptions("MIT AND Apache-2.0", options)
+	assert.EqualError(t, err, "unknown license 'Apache-2.0' at offset 8")
+}
+
+func testLicenseListJSON(version string, ids ...string) []byte {
+	licenses := make([]string, len(ids))
+	for i, id := range ids {
+		licenses[i] = `{"licenseId": "` + id + `", "isDeprecatedLicenseId": false}`
+	}
+	return []byte(`{"licenseListVersion": "` + version + `", "licenses": [` + strings.Join(licenses, ", ") + `]}`)
+}
+
+func TestLicenseLists(t *testing.T) {
+	lists, err := LoadLicenseLists(fstest.MapFS{
+		"3.9/json/licenses.json":    {Data: testLicenseListJSON("3.9", "MIT")},
+		"3.9/json/exceptions.json":  {Data: []byte(`{"licenseListVersion": "3.9", "exceptions": []}`)},
+		"v3.19/licenses.json":       {Data: testLicenseListJSON("3.19", "MIT", "Future-1.0")},
+		"v3.19/exceptions.json":     {Data: []byte(`{"licenseListVersion": "3.19", "exceptions": []}`)},
+		"README.md":                 {Data: []byte("license list snapshots")},
+		"3.9/json/other/notes.json": {Data: []byte("{}")},
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"3.9", "3.19", spdxlicenses.Version()}, lists.Versions())
+
+	list, err := lists.Get(spdxlicenses.Version())
+	require.NoError(t, err)
+	assert.Same(t, DefaultLicenseList(), list)
+
+	tests := []struct {
+		version string
+		valid   bool
+	}{
+		{"3.9", false},
+		{"3.19", true},
+		{"v3.19", true},
+		{" V3.19 ", true},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.version, func(t *testing.T) {
+			list, err := lists.Get(test.version)
+			require.NoError(t, err)
+			valid, _ := ValidateLicensesWithOptions([]string{"MIT AND Future-1.0"}, ValidateLicensesOptions{LicenseList: list})
+			assert.Equal(t, test.valid, valid)
+		})
+	}
+
+	_, err = lists.Get("3.20")
+	assert.True(t, errors.Is(err, ErrUnknownLicenseListVersion))
+	assert.EqualError(t, err, "unknown license list version '3.20'")
+}
+
+func TestLicenseListsError(t *testing.T) {
+	_, err := LoadLicenseLists(fstest.MapFS{
+		"3.9/licenses.json":   {Data: testLicenseListJSON("", "MIT")},
+		"3.9/exceptions.json": {Data: []byte(`{"exceptions": []}`)},
+	})
+	assert.EqualError(t, err, "load license list '3.9': license list does not have a licenseListVersion")
+
+	_, err = LoadLicenseLists(fstest.MapFS{
+		"3.9/licenses.json": {Data: testLicenseListJSON("3.9", "MIT")},
+	})
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+
+	list, err := ReadLicenseList(bytes.NewReader(testLicenseListJSON("", "MIT")), strings.NewReader(`{"exceptions": []}`))
+	require.NoError(t, err)
+	_, err = NewLicenseLists(list)
+	assert.EqualError(t, err, "license list does not have a licenseListVersion")
+}
+
+func TestCompareListVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"3.19", "3.19", 0},
+		{"v3.19", "3.19", 0},
+		{"3.9", "3.19", -1},
+		{"3.19", "3.9", 1},
+		{"3.19", "3.19.1", -1},
+		{"2.6", "3.0", -1},
+		{"3.0", "3.0-rc", -1},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.a+" "+test.b, func(t *testing.T) {
+			c := compareListVersions(test.a, test.b)
+			switch {
+			case test.expected < 0:
+				assert.Negative(t, c)
+			case test.expected > 0:
+				assert.Positive(t, c)
+			default:
+				assert.Zero(t, c)
+			}
+		})
+	}
+}
@@ -117,6 +117,36 @@ func TestExceptionLicense(t *testing.T) {
 	}
 }
 
+func TestDeprecatedException(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputID  string
+		outputID string
+		result   bool
+	}{
+		{"deprecated license - direct match", "eCos-2.0", "eCos-2.0", false},
+		{"exception license - direct match", "Bison-exception-2.2", "Bison-exception-2.2", false},
+		{"deprecated exception license - direct match", "Nokia-Qt-exception-1.1", "Nokia-Qt-exception-1.1", true},
+		{"deprecated exception license - all upper", "NOKIA-QT-EXCEPTION-1.1", "Nokia-Qt-exception-1.1", true},
+		{"deprecated exception license - all lower", "nokia-qt-exception-1.1", "Nokia-Qt-exception-1.1", true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			result, license := deprecatedException((test.inputID))
+			assert.Equal(t, test.result, result)
+			if result {
+				// updated to the proper case if found
+				assert.Equal(t, test.outputID, license)
+			} else {
+				// no change in case if not found
+				assert.Equal(t, test.inputID, license)
+			}
+		})
+	}
+}
+
 func TestGetLicenseRange(t *testing.T) {
 	tests := []struct {
 		name         string
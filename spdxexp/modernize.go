@@ -0,0 +1,128 @@
+package spdxexp
+
+import (
+	"strings"
+
+	"github.com/github/go-spdx/v2/spdxexp/spdxlicenses"
+)
+
+// reasonDeprecated is the Rewrite.Reason of a deprecated license id replaced by Modernize.
+const reasonDeprecated = "deprecated license id replaced by its current SPDX equivalent"
+
+// ModernizeResult is the result of Modernize.
+type ModernizeResult struct {
+	// Expression is the expression with deprecated license ids replaced.  Its spans refer to the
+	// original expression.
+	Expression *Expression
+	// Rewrites lists every deprecated license id that was replaced in the order they occur.
+	Rewrites []Rewrite
+}
+
+// Modernize parses an SPDX license expression and replaces deprecated license ids with their
+// current SPDX equivalents, for example:
+//   - "GPL-2.0" is "GPL-2.0-only" and "GPL-2.0+" is "GPL-2.0-or-later"
+//   - "GPL-2.0-with-classpath-exception" is "GPL-2.0-only WITH Classpath-exception-2.0"
+//   - "wxWindows" is "LGPL-2.0-or-later WITH WxWindows-exception-3.1"
+//
+// Each replacement is reported as a Rewrite with HighConfidence, since the SPDX equivalent has the
+// same meaning as the deprecated id.  Deprecated ids without an equivalent (e.g. "Net-SNMP") are
+// not changed, nor are deprecated ids with a + that have no equivalent with a + (e.g.
+// "wxWindows+") or with an exception when their equivalent already has one.  The expression is
+// always parsed with the license list embedded in the spdxlicenses package, which the
+// replacements are generated from.
+// Returns an error if the expression cannot be parsed.
+func Modernize(expression string) (*ModernizeResult, error) {
+	n, spans, err := parseWithSpans(expression, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &modernizer{source: expression, spans: make(map[*node]Span, len(spans))}
+	for n, span := range spans {
+		m.spans[n] = span
+	}
+	n = m.modernizeNode(n)
+	return &ModernizeResult{Expression: newExpression(n, m.spans), Rewrites: m.rewrites}, nil
+}
+
+type modernizer struct {
+	source   string
+	spans    map[*node]Span // spans of the parsed nodes and the nodes that replace them
+	rewrites []Rewrite
+}
+
+// modernizeNode returns the node with deprecated licenses replaced.  Nodes that do not change are
+// returned as is, so the tree is only copied along the paths to replaced licenses.
+func (m *modernizer) modernizeNode(n *node) *node {
+	if n.isExpression() {
+		left := m.modernizeNode(n.left())
+		right := m.modernizeNode(n.right())
+		if left == n.left() && right == n.right() {
+			return n
+		}
+		return m.replaceNode(n, joinNodes(left, *n.conjunction(), right))
+	}
+	if !n.isLicense() {
+		return n
+	}
+
+	span, ok := m.spans[n]
+	if !ok {
+		return n
+	}
+	// the span of a license includes its exception, so the license is the first word of the span
+	original := strings.Fields(m.source[span.Start:span.End])[0]
+	licenseSpan := Span{Start: span.Start, End: span.Start + len(original)}
+
+	if deprecated, _ := deprecatedLicense(*n.license()); !deprecated {
+		// the parser already replaces deprecated ids with + that have an -or-later variant (e.g.
+		// "GPL-2.0+" is parsed as "GPL-2.0-or-later"), which is still reported as a rewrite
+		if ok, replacement := spdxlicenses.DeprecatedReplacement(original); ok && replacement == *n.license() {
+			m.rewrite(original, replacement, licenseSpan)
+		}
+		return n
+	}
+
+	id := *n.license()
+	if n.hasPlus() {
+		id += "+"
+	}
+	ok, replacement := spdxlicenses.DeprecatedReplacement(id)
+	if !ok {
+		return n
+	}
+	replacementNode, err := parse(replacement)
+	if err != nil {
+		return n
+	}
+	if n.hasException() {
+		if !replacementNode.isLicense() || replacementNode.hasException() {
+			// the license cannot have two exceptions
+			return n
+		}
+		lic := *replacementNode.lic
+		lic.hasException = true
+		lic.exception = *n.exception()
+		replacementNode = &node{role: licenseNode, lic: &lic}
+	}
+	m.rewrite(original, replacement, licenseSpan)
+	return m.replaceNode(n, replacementNode)
+}
+
+// replaceNode gives the replacement the span of the node it replaces.
+func (m *modernizer) replaceNode(n, replacement *node) *node {
+	if span, ok := m.spans[n]; ok {
+		m.spans[replacement] = span
+	}
+	return replacement
+}
+
+func (m *modernizer) rewrite(original, replacement string, span Span) {
+	m.rewrites = append(m.rewrites, Rewrite{
+		Original:    original,
+		Replacement: replacement,
+		Span:        span,
+		Reason:      reasonDeprecated,
+		Confidence:  HighConfidence,
+	})
+}
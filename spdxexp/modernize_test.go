@@ -0,0 +1,90 @@
+package spdxexp
+
+import (
+	"testing"
+
+	"github.com/github/go-spdx/v2/spdxexp/spdxlicenses"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModernize(t *testing.T) {
+	tests := []struct {
+		name         string
+		expression   string
+		modernized   string
+		replacements []string // "Original -> Replacement" for each rewrite
+	}{
+		{"current ids are unchanged", "MIT OR GPL-2.0-only", "MIT OR GPL-2.0-only", nil},
+		{"deprecated id", "GPL-2.0", "GPL-2.0-only",
+			[]string{"GPL-2.0 -> GPL-2.0-only"}},
+		{"deprecated id ignoring case", "lgpl-2.1", "LGPL-2.1-only",
+			[]string{"lgpl-2.1 -> LGPL-2.1-only"}},
+		{"deprecated id with +", "GPL-2.0+", "GPL-2.0-or-later",
+			[]string{"GPL-2.0+ -> GPL-2.0-or-later"}},
+		{"deprecated id with exception", "GPL-2.0-with-classpath-exception", "GPL-2.0-only WITH Classpath-exception-2.0",
+			[]string{"GPL-2.0-with-classpath-exception -> GPL-2.0-only WITH Classpath-exception-2.0"}},
+		{"deprecated id replaced by license with exception", "wxWindows", "LGPL-2.0-or-later WITH WxWindows-exception-3.1",
+			[]string{"wxWindows -> LGPL-2.0-or-later WITH WxWindows-exception-3.1"}},
+		{"deprecated id renamed", "StandardML-NJ", "SMLNJ",
+			[]string{"StandardML-NJ -> SMLNJ"}},
+		{"exception is kept", "GPL-3.0 WITH GCC-exception-3.1", "GPL-3.0-only WITH GCC-exception-3.1",
+			[]string{"GPL-3.0 -> GPL-3.0-only"}},
+		{"expression", "MIT AND (GPL-2.0+ OR wxWindows) AND LGPL-3.0", "MIT AND (GPL-2.0-or-later OR LGPL-2.0-or-later WITH WxWindows-exception-3.1) AND LGPL-3.0-only",
+			[]string{"GPL-2.0+ -> GPL-2.0-or-later", "wxWindows -> LGPL-2.0-or-later WITH WxWindows-exception-3.1", "LGPL-3.0 -> LGPL-3.0-only"}},
+		{"no equivalent", "Net-SNMP", "Net-SNMP", nil},
+		{"no equivalent with +", "wxWindows+", "wxWindows+", nil},
+		{"two exceptions", "GPL-2.0-with-classpath-exception WITH Bison-exception-2.2", "GPL-2.0-with-classpath-exception WITH Bison-exception-2.2", nil},
+		{"special value", "NOASSERTION", "NOASSERTION", nil},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			result, err := Modernize(test.expression)
+			require.NoError(t, err)
+			assert.Equal(t, test.modernized, result.Expression.String())
+
+			var replacements []string
+			for _, rewrite := range result.Rewrites {
+				replacements = append(replacements, rewrite.Original+" -> "+rewrite.Replacement)
+				assert.Equal(t, rewrite.Original, test.expression[rewrite.Span.Start:rewrite.Span.End])
+				assert.Equal(t, HighConfidence, rewrite.Confidence)
+				assert.NotEmpty(t, rewrite.Reason)
+			}
+			assert.Equal(t, test.replacements, replacements)
+		})
+	}
+}
+
+func TestModernizeSpans(t *testing.T) {
+	source := "MIT OR (wxWindows AND GPL-2.0 WITH Bison-exception-2.2)"
+	result, err := Modernize(source)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"MIT OR (wxWindows AND GPL-2.0 WITH Bison-exception-2.2)",
+		"MIT",
+		"wxWindows AND GPL-2.0 WITH Bison-exception-2.2",
+		"wxWindows",
+		"GPL-2.0 WITH Bison-exception-2.2",
+	}, spanTexts(t, source, result.Expression))
+}
+
+func TestModernizeError(t *testing.T) {
+	result, err := Modernize("GPL-2.0 AND")
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "expected expression following AND, but found none")
+}
+
+func TestDeprecatedReplacements(t *testing.T) {
+	// every replacement is a current SPDX expression in its normalized form
+	for id, replacement := range spdxlicenses.GetDeprecatedReplacementsMap() {
+		parsed, err := Parse(replacement)
+		require.NoError(t, err, id)
+		assert.Equal(t, replacement, parsed.String(), id)
+
+		result, err := Modernize(replacement)
+		require.NoError(t, err, id)
+		assert.Empty(t, result.Rewrites, id)
+	}
+}
@@ -17,13 +17,15 @@ const (
 	expressionNode nodeRole = iota
 	licenseRefNode
 	licenseNode
+	specialValueNode
 )
 
 type node struct {
-	role nodeRole
-	exp  *expressionNodePartial
-	lic  *licenseNodePartial
-	ref  *referenceNodePartial
+	role    nodeRole
+	exp     *expressionNodePartial
+	lic     *licenseNodePartial
+	ref     *referenceNodePartial
+	special string // NONE or NOASSERTION for a special value node
 }
 
 type expressionNodePartial struct {
@@ -43,6 +45,8 @@ type referenceNodePartial struct {
 	hasDocumentRef bool
 	documentRef    string
 	licenseRef     string
+	hasException   bool
+	exception      string
 }
 
 // ---------------------- Helper Methods ----------------------
@@ -103,6 +107,9 @@ func (n *node) exception() *string {
 	if !n.hasException() {
 		return nil
 	}
+	if n.isLicenseRef() {
+		return &(n.ref.exception)
+	}
 	return &(n.lic.exception)
 }
 
@@ -114,6 +121,9 @@ func (n *node) hasPlus() bool {
 }
 
 func (n *node) hasException() bool {
+	if n.isLicenseRef() {
+		return n.ref.hasException
+	}
 	if !n.isLicense() {
 		return false
 	}
@@ -124,6 +134,17 @@ func (n *node) isLicenseRef() bool {
 	return n.role == licenseRefNode
 }
 
+func (n *node) isSpecialValue() bool {
+	return n.role == specialValueNode
+}
+
+func (n *node) specialValue() *string {
+	if !n.isSpecialValue() {
+		return nil
+	}
+	return &(n.special)
+}
+
 func (n *node) licenseRef() *string {
 	if !n.isLicenseRef() {
 		return nil
@@ -145,8 +166,8 @@ func (n *node) hasDocumentRef() bool {
 	return n.ref.hasDocumentRef
 }
 
-// reconstructedLicenseString returns the string representation of a license, license ref, or expression.
-// TODO: Original had "NOASSERTION".  Does that still apply?
+// reconstructedLicenseString returns the string representation of a license, license ref, special
+// value, or expression.
 func (n *node) reconstructedLicenseString() *string {
 	switch n.role {
 	case expressionNode:
@@ -165,7 +186,12 @@ func (n *node) reconstructedLicenseString() *string {
 		if n.hasDocumentRef() {
 			license = "DocumentRef-" + *n.documentRef() + ":" + license
 		}
+		if n.hasException() {
+			license += " WITH " + *n.exception()
+		}
 		return &license
+	case specialValueNode:
+		return n.specialValue()
 	}
 	return nil
 }
@@ -301,6 +327,9 @@ func (nodes *nodePair) licenseRefsAreCompatible() bool {
 	if !nodes.firstNode.isLicenseRef() || !nodes.secondNode.isLicenseRef() {
 		return false
 	}
+	if !nodes.exceptionsAreCompatible() {
+		return false
+	}
 
 	compatible := *nodes.firstNode.licenseRef() == *nodes.secondNode.licenseRef()
 	compatible = compatible && (nodes.firstNode.hasDocumentRef() == nodes.secondNode.hasDocumentRef())
@@ -335,7 +364,8 @@ func (nodes *nodePair) identifierInRange() bool {
 }
 
 // exceptionsAreCompatible returns true if neither license has an exception or they have
-// the same exception; otherwise, false
+// the same exception; otherwise, false.  Custom additions (e.g. "AdditionRef-Custom") must
+// match exactly, including any document reference.
 func (nodes *nodePair) exceptionsAreCompatible() bool {
 	firstNode := *nodes.firstNode
 	secondNode := *nodes.secondNode
@@ -353,6 +383,38 @@ func (nodes *nodePair) exceptionsAreCompatible() bool {
 	return *nodes.firstNode.exception() == *nodes.secondNode.exception()
 }
 
+// implies returns true if the first license implies the second license, meaning that complying
+// with the first license also complies with the second (e.g. "GPL-3.0-only" implies
+// "GPL-2.0-or-later", but not the reverse); otherwise, false.  Licenses with a + are treated as a
+// single license, so "GPL-2.0+" implies "GPL-1.0+", but not "GPL-2.0 OR GPL-3.0+".  License
+// references and special values only imply themselves.
+func (nodes *nodePair) implies() bool {
+	first := nodes.firstNode
+	second := nodes.secondNode
+
+	if first.isSpecialValue() || second.isSpecialValue() {
+		return first.isSpecialValue() && second.isSpecialValue() && *first.specialValue() == *second.specialValue()
+	}
+	if first.isLicenseRef() || second.isLicenseRef() {
+		return nodes.licenseRefsAreCompatible()
+	}
+	if !first.isLicense() || !second.isLicense() || !nodes.exceptionsAreCompatible() {
+		return false
+	}
+	if nodes.licensesExactlyEqual() {
+		return true
+	}
+	if second.hasPlus() {
+		// first or first+ must be in the range of second+
+		return nodes.identifierInRange()
+	}
+	if first.hasPlus() {
+		// first+ allows later versions that second does not
+		return false
+	}
+	return nodes.rangesEqual()
+}
+
 // rangesEqual returns true if the licenses are in the same range; otherwise, false
 // (e.g. GPL-2.0-only == GPL-2.0)
 func (nodes *nodePair) rangesEqual() bool {
@@ -0,0 +1,269 @@
+package spdxexp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Default limits used by ToDNF and ToCNF when NormalFormOptions does not set them.
+const (
+	DefaultMaxClauses = 10000
+	DefaultMaxTerms   = 100000
+)
+
+// NormalFormOptions limits the size of the normal forms computed by ToDNF and ToCNF.  Converting
+// an expression to a normal form can multiply the number of clauses (e.g. the DNF of
+// "(A OR B) AND (C OR D) AND (E OR F)" has 8 clauses), so the size of the result is checked while
+// it is computed.
+type NormalFormOptions struct {
+	// MaxClauses is the maximum number of clauses in the result.  Zero uses DefaultMaxClauses.
+	MaxClauses int
+	// MaxTerms is the maximum number of licenses in all clauses of the result combined.  Zero
+	// uses DefaultMaxTerms.
+	MaxTerms int
+	// LicenseList is the license list that license and exception ids are looked up in.  When nil,
+	// the list embedded in the spdxlicenses package is used.
+	LicenseList *LicenseList
+}
+
+// NormalFormLimitError is returned by ToDNF and ToCNF when the normal form exceeds a limit in
+// NormalFormOptions.
+type NormalFormLimitError struct {
+	// Limit is the name of the limit that was exceeded, "clauses" or "terms".
+	Limit string
+	// Max is the value of the limit.
+	Max int
+}
+
+// Error returns a human readable description of the error.
+func (e *NormalFormLimitError) Error() string {
+	return fmt.Sprintf("normal form has more than %d %s", e.Max, e.Limit)
+}
+
+// ToDNF converts an SPDX license expression to disjunctive normal form, which is a list of
+// clauses where each clause is a list of licenses that are ANDed, and the clauses are ORed.  Each
+// clause is a set of licenses that can be chosen to comply with the expression.
+//
+// Example:
+//
+//	"MIT AND (Apache-2.0 OR GPL-2.0)" becomes [["Apache-2.0", "MIT"], ["GPL-2.0", "MIT"]]
+//
+// Licenses are normalized as by Parse and sorted within each clause, and clauses are sorted.
+// Duplicate licenses and clauses are removed, as are clauses that include all of the licenses of
+// another clause (e.g. "MIT OR (MIT AND ISC)" becomes [["MIT"]]).
+// Returns error if the expression cannot be parsed, or a *NormalFormLimitError if the result
+// exceeds the limits in options.
+func ToDNF(expression string, options NormalFormOptions) ([][]string, error) {
+	return toNormalForm(expression, "or", options)
+}
+
+// ToCNF converts an SPDX license expression to conjunctive normal form, which is a list of
+// clauses where each clause is a list of licenses that are ORed, and the clauses are ANDed.  Each
+// clause is a choice of licenses that must be made to comply with the expression.
+//
+// Example:
+//
+//	"MIT OR (Apache-2.0 AND GPL-2.0)" becomes [["Apache-2.0", "MIT"], ["GPL-2.0", "MIT"]]
+//
+// Licenses are normalized as by Parse and sorted within each clause, and clauses are sorted.
+// Duplicate licenses and clauses are removed, as are clauses that include all of the licenses of
+// another clause (e.g. "MIT AND (MIT OR ISC)" becomes [["MIT"]]).
+// Returns error if the expression cannot be parsed, or a *NormalFormLimitError if the result
+// exceeds the limits in options.
+func ToCNF(expression string, options NormalFormOptions) ([][]string, error) {
+	return toNormalForm(expression, "and", options)
+}
+
+func toNormalForm(expression string, outer string, options NormalFormOptions) ([][]string, error) {
+	n, err := parseWithLicenses(expression, options.LicenseList)
+	if err != nil {
+		return nil, err
+	}
+	if options.MaxClauses <= 0 {
+		options.MaxClauses = DefaultMaxClauses
+	}
+	if options.MaxTerms <= 0 {
+		options.MaxTerms = DefaultMaxTerms
+	}
+
+	clauses, err := normalForm(n, outer, options)
+	if err != nil {
+		return nil, err
+	}
+	clauses = absorbClauses(clauses)
+	sortClauses(clauses)
+	return clauses, nil
+}
+
+// normalForm returns the clauses of the normal form of a node.  The clauses are joined by the
+// outer conjunction and the licenses in each clause are joined by the other conjunction.
+func normalForm(n *node, outer string, options NormalFormOptions) ([][]string, error) {
+	if !n.isExpression() {
+		return [][]string{{*n.reconstructedLicenseString()}}, nil
+	}
+
+	left, err := normalForm(n.left(), outer, options)
+	if err != nil {
+		return nil, err
+	}
+	right, err := normalForm(n.right(), outer, options)
+	if err != nil {
+		return nil, err
+	}
+
+	result := newClauseSet(options)
+	if *n.conjunction() == outer {
+		// the clauses of both sides are clauses of the result
+		// Example: DNF of "(A AND B) OR C" is [[A, B], [C]]
+		for _, clause := range append(left, right...) {
+			if err := result.add(clause); err != nil {
+				return nil, err
+			}
+		}
+		return result.clauses, nil
+	}
+
+	// each clause of the left side is combined with each clause of the right side
+	// Example: DNF of "(A OR B) AND C" is [[A, C], [B, C]]
+	for _, l := range left {
+		for _, r := range right {
+			clause := make([]string, 0, len(l)+len(r))
+			clause = append(append(clause, l...), r...)
+			if err := result.add(clause); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result.clauses, nil
+}
+
+// dnfBranches returns the clauses of an expression in disjunctive normal form, as by ToDNF with
+// the default limits, with the license node for each license.
+func dnfBranches(n *node) ([][]*node, error) {
+	clauses, err := normalForm(n, "or", NormalFormOptions{MaxClauses: DefaultMaxClauses, MaxTerms: DefaultMaxTerms})
+	if err != nil {
+		return nil, err
+	}
+	clauses = absorbClauses(clauses)
+	sortClauses(clauses)
+
+	leaves := map[string]*node{}
+	collectLeaves(n, leaves)
+	branches := make([][]*node, len(clauses))
+	for i, clause := range clauses {
+		branches[i] = make([]*node, len(clause))
+		for j, license := range clause {
+			branches[i][j] = leaves[license]
+		}
+	}
+	return branches, nil
+}
+
+// collectLeaves adds each license, license ref, and special value in an expression to leaves,
+// keyed by its reconstructed license string.
+func collectLeaves(n *node, leaves map[string]*node) {
+	if n.isExpression() {
+		collectLeaves(n.left(), leaves)
+		collectLeaves(n.right(), leaves)
+		return
+	}
+	license := *n.reconstructedLicenseString()
+	if _, ok := leaves[license]; !ok {
+		leaves[license] = n
+	}
+}
+
+// clauseSet collects distinct clauses, failing when the limits are exceeded.
+type clauseSet struct {
+	clauses [][]string
+	seen    map[string]struct{}
+	terms   int
+	options NormalFormOptions
+}
+
+func newClauseSet(options NormalFormOptions) *clauseSet {
+	return &clauseSet{seen: map[string]struct{}{}, options: options}
+}
+
+// Add a clause to the set after sorting it and removing duplicate licenses.  Clauses that are
+// already in the set are ignored.
+func (s *clauseSet) add(clause []string) error {
+	sort.Strings(clause)
+	deduped := clause[:0]
+	for i, license := range clause {
+		if i == 0 || license != clause[i-1] {
+			deduped = append(deduped, license)
+		}
+	}
+
+	key := strings.Join(deduped, "\x00")
+	if _, ok := s.seen[key]; ok {
+		return nil
+	}
+	if len(s.clauses) >= s.options.MaxClauses {
+		return &NormalFormLimitError{Limit: "clauses", Max: s.options.MaxClauses}
+	}
+	if s.terms+len(deduped) > s.options.MaxTerms {
+		return &NormalFormLimitError{Limit: "terms", Max: s.options.MaxTerms}
+	}
+	s.seen[key] = struct{}{}
+	s.terms += len(deduped)
+	s.clauses = append(s.clauses, deduped)
+	return nil
+}
+
+// Remove clauses that include all of the licenses of another clause.  Clauses must be distinct
+// and sorted.
+func absorbClauses(clauses [][]string) [][]string {
+	// shorter clauses can only absorb longer clauses, so check them first
+	sort.SliceStable(clauses, func(i, j int) bool {
+		return len(clauses[i]) < len(clauses[j])
+	})
+
+	var kept [][]string
+	for _, clause := range clauses {
+		absorbed := false
+		for _, k := range kept {
+			if len(k) < len(clause) && isSortedSubset(k, clause) {
+				absorbed = true
+				break
+			}
+		}
+		if !absorbed {
+			kept = append(kept, clause)
+		}
+	}
+	return kept
+}
+
+// Return true if every string in the sorted subset is also in the sorted set.
+func isSortedSubset(subset, set []string) bool {
+	i := 0
+	for _, s := range set {
+		if i < len(subset) && subset[i] == s {
+			i++
+		}
+	}
+	return i == len(subset)
+}
+
+// Sort clauses, comparing licenses in order.
+func sortClauses(clauses [][]string) {
+	sort.Slice(clauses, func(i, j int) bool {
+		return lessClause(clauses[i], clauses[j])
+	})
+}
+
+// Return true if clause a sorts before clause b, comparing licenses in order.
+func lessClause(a, b []string) bool {
+	for k := range a {
+		if k >= len(b) {
+			return false
+		}
+		if a[k] != b[k] {
+			return a[k] < b[k]
+		}
+	}
+	return len(a) < len(b)
+}
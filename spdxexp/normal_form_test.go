@@ -0,0 +1,116 @@
+package spdxexp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToDNF(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		clauses    [][]string
+		err        error
+	}{
+		{"single license", "mit", [][]string{{"MIT"}}, nil},
+		{"special value", "NONE", [][]string{{"NONE"}}, nil},
+		{"OR", "MIT OR Apache-2.0", [][]string{{"Apache-2.0"}, {"MIT"}}, nil},
+		{"AND", "MIT AND Apache-2.0", [][]string{{"Apache-2.0", "MIT"}}, nil},
+		{"AND distributed over OR", "MIT AND (Apache-2.0 OR GPL-2.0)",
+			[][]string{{"Apache-2.0", "MIT"}, {"GPL-2.0", "MIT"}}, nil},
+		{"ANDed ORs", "(MIT OR ISC) AND (Apache-2.0 OR GPL-2.0+)",
+			[][]string{{"Apache-2.0", "ISC"}, {"Apache-2.0", "MIT"}, {"GPL-2.0-or-later", "ISC"}, {"GPL-2.0-or-later", "MIT"}}, nil},
+		{"duplicate licenses", "MIT AND (MIT OR ISC) AND ISC", [][]string{{"ISC", "MIT"}}, nil},
+		{"duplicate clauses", "MIT OR Apache-2.0 OR MIT", [][]string{{"Apache-2.0"}, {"MIT"}}, nil},
+		{"absorbed clauses", "MIT OR (MIT AND ISC)", [][]string{{"MIT"}}, nil},
+		{"exceptions and refs", "GPL-2.0 WITH Classpath-exception-2.0 AND (LicenseRef-X OR DocumentRef-d:LicenseRef-Y)",
+			[][]string{{"DocumentRef-d:LicenseRef-Y", "GPL-2.0 WITH Classpath-exception-2.0"}, {"GPL-2.0 WITH Classpath-exception-2.0", "LicenseRef-X"}}, nil},
+		{"invalid expression", "MIT AND", nil, errors.New("expected expression following AND, but found none")},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			clauses, err := ToDNF(test.expression, NormalFormOptions{})
+			requireEqualError(t, test.err, err)
+			assert.Equal(t, test.clauses, clauses)
+		})
+	}
+}
+
+func TestToCNF(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		clauses    [][]string
+		err        error
+	}{
+		{"single license", "MIT", [][]string{{"MIT"}}, nil},
+		{"OR", "MIT OR Apache-2.0", [][]string{{"Apache-2.0", "MIT"}}, nil},
+		{"AND", "MIT AND Apache-2.0", [][]string{{"Apache-2.0"}, {"MIT"}}, nil},
+		{"OR distributed over AND", "MIT OR (Apache-2.0 AND GPL-2.0)",
+			[][]string{{"Apache-2.0", "MIT"}, {"GPL-2.0", "MIT"}}, nil},
+		{"ORed ANDs", "(MIT AND ISC) OR (Apache-2.0 AND GPL-2.0)",
+			[][]string{{"Apache-2.0", "ISC"}, {"Apache-2.0", "MIT"}, {"GPL-2.0", "ISC"}, {"GPL-2.0", "MIT"}}, nil},
+		{"absorbed clauses", "MIT AND (MIT OR ISC)", [][]string{{"MIT"}}, nil},
+		{"invalid expression", "MIT OR", nil, errors.New("expected expression following OR, but found none")},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			clauses, err := ToCNF(test.expression, NormalFormOptions{})
+			requireEqualError(t, test.err, err)
+			assert.Equal(t, test.clauses, clauses)
+		})
+	}
+}
+
+func TestNormalFormLimits(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		options    NormalFormOptions
+		err        *NormalFormLimitError
+	}{
+		{"within limits", "(MIT OR ISC) AND (Apache-2.0 OR 0BSD)", NormalFormOptions{MaxClauses: 4, MaxTerms: 8}, nil},
+		{"too many clauses", "(MIT OR ISC) AND (Apache-2.0 OR 0BSD)", NormalFormOptions{MaxClauses: 3},
+			&NormalFormLimitError{Limit: "clauses", Max: 3}},
+		{"too many terms", "(MIT OR ISC) AND (Apache-2.0 OR 0BSD)", NormalFormOptions{MaxTerms: 7},
+			&NormalFormLimitError{Limit: "terms", Max: 7}},
+		{"too many clauses in sub-expression", "((MIT OR ISC) AND (Apache-2.0 OR 0BSD)) OR GPL-2.0", NormalFormOptions{MaxClauses: 2},
+			&NormalFormLimitError{Limit: "clauses", Max: 2}},
+		{"default clause limit", manyANDedORs(20), NormalFormOptions{MaxTerms: 1 << 30},
+			&NormalFormLimitError{Limit: "clauses", Max: DefaultMaxClauses}},
+		{"default term limit", manyANDedORs(20), NormalFormOptions{MaxClauses: 1 << 30},
+			&NormalFormLimitError{Limit: "terms", Max: DefaultMaxTerms}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ToDNF(test.expression, test.options)
+			if test.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			var limitErr *NormalFormLimitError
+			require.True(t, errors.As(err, &limitErr))
+			assert.Equal(t, test.err, limitErr)
+		})
+	}
+}
+
+// Return an expression with n ANDed ORs of distinct license refs, which has 2^n clauses in DNF.
+func manyANDedORs(n int) string {
+	ors := make([]string, n)
+	for i := range ors {
+		ors[i] = fmt.Sprintf("(LicenseRef-%da OR LicenseRef-%db)", i, i)
+	}
+	return strings.Join(ors, " AND ")
+}
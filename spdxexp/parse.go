@@ -1,7 +1,6 @@
 package spdxexp
 
 import (
-	"errors"
 	"strings"
 )
 
@@ -10,31 +9,71 @@ import (
 // This parser follows the operator precedence defined in the
 // `Order of Precedence and Parentheses` section.
 
+// atomExpected lists the tokens that can start a license or expression.
+var atomExpected = []string{expectedLicense, expectedLicenseRef, expectedDocumentRef, "("}
+
 type tokenStream struct {
 	tokens []token
 	index  int
 	err    error
+	end    int            // length of the expression, used as the offset of errors at the end of the expression
+	spans  map[*node]Span // when not nil, the span of each parsed node is recorded
 }
 
 func parse(source string) (*node, error) {
+	return parseWithLicenses(source, nil)
+}
+
+// parseWithLicenses parses like parse, looking up ids in the license list, or the default list if
+// it is nil.
+func parseWithLicenses(source string, licenses *LicenseList) (*node, error) {
+	tokns, err := newTokenStream(source, licenses)
+	if err != nil {
+		return nil, err
+	}
+	return tokns.parseTokens(), tokns.err
+}
+
+// parseWithSpans parses like parseWithLicenses and also returns the span of each node in the tree.
+func parseWithSpans(source string, licenses *LicenseList) (*node, map[*node]Span, error) {
+	tokns, err := newTokenStream(source, licenses)
+	if err != nil {
+		return nil, nil, err
+	}
+	tokns.spans = map[*node]Span{}
+	node := tokns.parseTokens()
+	if tokns.err != nil {
+		return nil, nil, tokns.err
+	}
+	return node, tokns.spans, nil
+}
+
+func newTokenStream(source string, licenses *LicenseList) (*tokenStream, error) {
 	if len(source) == 0 {
-		return nil, errors.New("parse error - cannot parse empty string")
+		return nil, newParseError(EmptyExpression, 0, "", "parse error - cannot parse empty string")
 	}
-	tokens, err := scan(source)
+	tokens, err := scan(source, licenses)
 	if err != nil {
 		return nil, err
 	}
-	tokns := &tokenStream{tokens: tokens, index: 0, err: nil}
-	return tokns.parseTokens(), tokns.err
+	return &tokenStream{tokens: tokens, index: 0, err: nil, end: len(source)}, nil
 }
 
 func (t *tokenStream) parseTokens() *node {
 	if len(t.tokens) == 0 {
 		// malformed with no tokens
-		t.err = errors.New("no tokens to parse")
+		t.fail(EmptyExpression, "no tokens to parse")
 		return nil
 	}
 
+	if len(t.tokens) == 1 && t.tokens[0].role == specialValueToken {
+		// NONE and NOASSERTION are only valid as the entire expression
+		node := &(node{role: specialValueNode, special: t.tokens[0].value})
+		t.next()
+		t.setSpan(node, 0)
+		return node
+	}
+
 	node := t.parseExpression()
 	if t.err != nil {
 		return nil
@@ -42,27 +81,30 @@ func (t *tokenStream) parseTokens() *node {
 
 	if node == nil {
 		// unable to parse expression for unknown reason
-		t.err = errors.New("syntax error")
+		t.fail(InvalidSyntax, "syntax error")
 		return nil
 	} else if t.hasMore() {
 		// malformed with too many tokens - try to determine the cause
 
 		// check for close parenthesis without matching open parenthesis
-		closeParen := t.parseOperator(")")
-		if closeParen != nil {
-			t.err = errors.New("close parenthesis does not have a matching open parenthesis")
+		if t.peekOperator(")") {
+			t.fail(UnbalancedParen, "close parenthesis does not have a matching open parenthesis", "AND", "OR")
+			return nil
+		}
+
+		if token := t.peek(); token.role == specialValueToken {
+			t.fail(MisplacedSpecialValue, token.value+" must be the entire expression")
 			return nil
 		}
 
 		// check for licenses without operator
-		lic := t.parseLicense()
-		if lic != nil {
-			t.err = errors.New("licenses or expressions are not separated by an operator")
+		if token := t.peek(); token.role == licenseToken {
+			t.fail(MissingOperator, "licenses or expressions are not separated by an operator", "AND", "OR")
 			return nil
 		}
 
 		// cannot determine what syntax error occurred
-		t.err = errors.New("syntax error")
+		t.fail(InvalidSyntax, "syntax error", "AND", "OR")
 		return nil
 	}
 
@@ -87,12 +129,55 @@ func (t *tokenStream) peek() *token {
 // Advance the index to the next token.
 func (t *tokenStream) next() {
 	if !t.hasMore() {
-		t.err = errors.New("read past end of tokens")
+		t.fail(InvalidSyntax, "read past end of tokens")
 		return
 	}
 	t.index++
 }
 
+// Return the byte offset of the current token.  If there are no more tokens, return the end
+// of the expression.
+func (t *tokenStream) offset() int {
+	if t.hasMore() {
+		return t.tokens[t.index].start
+	}
+	return t.end
+}
+
+// Set the error for the current token.  If there are no more tokens, the error is reported
+// at the end of the expression.
+func (t *tokenStream) fail(kind ParseErrorKind, msg string, expected ...string) {
+	found := ""
+	if token := t.peek(); token != nil {
+		found = token.value
+	}
+	t.err = newParseError(kind, t.offset(), found, msg, expected...)
+}
+
+// Set the error for the token before the current token, which has already been consumed.
+func (t *tokenStream) failPrevious(kind ParseErrorKind, msg string, expected ...string) {
+	token := t.tokens[t.index-1]
+	t.err = newParseError(kind, token.start, token.value, msg, expected...)
+}
+
+// Record the span of a node parsed from the token at index start through the last consumed token.
+// A node that already has a span (e.g. a parenthesized expression) keeps its span.
+func (t *tokenStream) setSpan(n *node, start int) {
+	if t.spans == nil || n == nil {
+		return
+	}
+	if _, ok := t.spans[n]; ok {
+		return
+	}
+	t.spans[n] = Span{Start: t.tokens[start].start, End: t.tokens[t.index-1].end}
+}
+
+// Return true if the current token is the operator without advancing the index.
+func (t *tokenStream) peekOperator(operator string) bool {
+	token := t.peek()
+	return token != nil && token.role == operatorToken && token.value == operator
+}
+
 func (t *tokenStream) parseParenthesizedExpression() *node {
 	openParen := t.parseOperator("(")
 	if openParen == nil {
@@ -107,13 +192,13 @@ func (t *tokenStream) parseParenthesizedExpression() *node {
 
 	if !t.hasMore() {
 		// no more tokens, so missing closing paren
-		t.err = errors.New("open parenthesis does not have a matching close parenthesis")
+		t.fail(UnbalancedParen, "open parenthesis does not have a matching close parenthesis", ")")
 		return nil
 	}
 
 	closeParen := t.parseOperator(")")
 	if closeParen == nil {
-		t.err = errors.New("open parenthesis does not have a matching close parenthesis")
+		t.fail(UnbalancedParen, "open parenthesis does not have a matching close parenthesis", ")")
 		return nil
 	}
 
@@ -151,9 +236,9 @@ func (t *tokenStream) parseAtom() *node {
 		operator := t.parseOperator(")")
 		if operator != nil {
 			if t.index == 1 {
-				t.err = errors.New("expression starts with close parenthesis")
+				t.failPrevious(UnbalancedParen, "expression starts with close parenthesis", atomExpected...)
 			} else {
-				t.err = errors.New("expected license or expression, but found close parenthesis")
+				t.failPrevious(MissingOperand, "expected license or expression, but found close parenthesis", atomExpected...)
 			}
 			return nil
 		}
@@ -161,9 +246,9 @@ func (t *tokenStream) parseAtom() *node {
 		operator = t.parseOperator("OR")
 		if operator != nil {
 			if t.index == 1 {
-				t.err = errors.New("expression starts with OR")
+				t.failPrevious(MissingOperand, "expression starts with OR", atomExpected...)
 			} else {
-				t.err = errors.New("expected license or expression, but found OR")
+				t.failPrevious(MissingOperand, "expected license or expression, but found OR", atomExpected...)
 			}
 			return nil
 		}
@@ -171,23 +256,39 @@ func (t *tokenStream) parseAtom() *node {
 		operator = t.parseOperator("AND")
 		if operator != nil {
 			if t.index == 1 {
-				t.err = errors.New("expression starts with AND")
+				t.failPrevious(MissingOperand, "expression starts with AND", atomExpected...)
 			} else {
-				t.err = errors.New("expected license or expression, but found AND")
+				t.failPrevious(MissingOperand, "expected license or expression, but found AND", atomExpected...)
 			}
 			return nil
 		}
 
+		if token := t.peek(); token.role == exceptionToken {
+			t.fail(InvalidSyntax, "expected license or expression, but found exception", atomExpected...)
+			return nil
+		}
+
+		if token := t.peek(); token.role == additionRefToken {
+			t.fail(MalformedReference, "'AdditionRef-...' is only allowed after 'WITH'", atomExpected...)
+			return nil
+		}
+
+		if token := t.peek(); token.role == specialValueToken {
+			t.fail(MisplacedSpecialValue, token.value+" must be the entire expression", atomExpected...)
+			return nil
+		}
+
 		// cannot determine what syntax error occurred
-		t.err = errors.New("syntax error")
+		t.fail(InvalidSyntax, "syntax error", atomExpected...)
 		return nil
 	}
 
-	t.err = errors.New("expected node, but found none")
+	t.fail(MissingOperand, "expected license or expression, but found none", atomExpected...)
 	return nil
 }
 
 func (t *tokenStream) parseExpression() *node {
+	start := t.index
 	left := t.parseAnd()
 	if t.err != nil {
 		return nil
@@ -208,7 +309,7 @@ func (t *tokenStream) parseExpression() *node {
 
 	if !t.hasMore() {
 		// expression found and no more tokens to process
-		t.err = errors.New("expected expression following OR, but found none")
+		t.fail(MissingOperand, "expected expression following OR, but found none", atomExpected...)
 		return nil
 	}
 
@@ -217,11 +318,11 @@ func (t *tokenStream) parseExpression() *node {
 		return nil
 	}
 	if right == nil {
-		t.err = errors.New("expected expression following OR, but found none")
+		t.fail(MissingOperand, "expected expression following OR, but found none", atomExpected...)
 		return nil
 	}
 
-	return &(node{
+	node := &(node{
 		role: expressionNode,
 		exp: &(expressionNodePartial{
 			left:        left,
@@ -229,12 +330,15 @@ func (t *tokenStream) parseExpression() *node {
 			right:       right,
 		}),
 	})
+	t.setSpan(node, start)
+	return node
 }
 
 // Return a node representation of an atomic value or an AND expression.  If a malformed
 // atomic value or expression is found, an error is returned.  Advances the index if a
 // valid atomic value or a valid expression is found.
 func (t *tokenStream) parseAnd() *node {
+	start := t.index
 	left := t.parseAtom()
 	if t.err != nil {
 		return nil
@@ -254,7 +358,7 @@ func (t *tokenStream) parseAnd() *node {
 
 	if !t.hasMore() {
 		// expression found and no more tokens to process
-		t.err = errors.New("expected expression following AND, but found none")
+		t.fail(MissingOperand, "expected expression following AND, but found none", atomExpected...)
 		return nil
 	}
 
@@ -263,24 +367,30 @@ func (t *tokenStream) parseAnd() *node {
 		return nil
 	}
 	if right == nil {
-		t.err = errors.New("expected expression following AND, but found none")
+		t.fail(MissingOperand, "expected expression following AND, but found none", atomExpected...)
 		return nil
 	}
 
 	exp := expressionNodePartial{left: left, conjunction: "and", right: right}
 
-	return &(node{
+	node := &(node{
 		role: expressionNode,
 		exp:  &exp,
 	})
+	t.setSpan(node, start)
+	return node
 }
 
 // Return a node representation of a License Reference.  If a malformed license reference is
 // found, an error is returned.  Advances the index if a valid license reference is found.
 func (t *tokenStream) parseLicenseRef() *node {
-	ref := referenceNodePartial{documentRef: "", hasDocumentRef: false, licenseRef: ""}
+	ref := referenceNodePartial{documentRef: "", hasDocumentRef: false, licenseRef: "", hasException: false, exception: ""}
+	start := t.index
 
 	token := t.peek()
+	if token == nil {
+		return nil
+	}
 	if token.role == documentRefToken {
 		ref.documentRef = token.value
 		ref.hasDocumentRef = true
@@ -288,16 +398,20 @@ func (t *tokenStream) parseLicenseRef() *node {
 
 		operator := t.parseOperator(":")
 		if operator == nil {
-			t.err = errors.New("expected ':' after 'DocumentRef-...'")
+			t.fail(MalformedReference, "expected ':' after 'DocumentRef-...'", ":")
 			return nil
 		}
 	}
 
 	token = t.peek()
-	if token.role != licenseRefToken && ref.hasDocumentRef {
-		t.err = errors.New("expected 'LicenseRef-...' after 'DocumentRef-...'")
+	if token != nil && token.role == additionRefToken && ref.hasDocumentRef {
+		t.fail(MalformedReference, "'DocumentRef-...:AdditionRef-...' is only allowed after 'WITH'", atomExpected...)
+		return nil
+	}
+	if (token == nil || token.role != licenseRefToken) && ref.hasDocumentRef {
+		t.fail(MalformedReference, "expected 'LicenseRef-...' after 'DocumentRef-...'", expectedLicenseRef)
 		return nil
-	} else if token.role != licenseRefToken {
+	} else if token == nil || token.role != licenseRefToken {
 		// not found is not an error as long as DocumentRef and : weren't the previous tokens
 		return nil
 	}
@@ -305,19 +419,33 @@ func (t *tokenStream) parseLicenseRef() *node {
 	ref.licenseRef = token.value
 	t.next()
 
-	return &(node{
+	if t.hasMore() {
+		exception := t.parseWith()
+		if t.err != nil {
+			return nil
+		}
+		if exception != nil {
+			ref.hasException = true
+			ref.exception = *exception
+		}
+	}
+
+	node := &(node{
 		role: licenseRefNode,
 		ref:  &ref,
 	})
+	t.setSpan(node, start)
+	return node
 }
 
 // Return a node representation of a License.  If a malformed license is found,
 // an error is returned.  Advances the index if a valid license is found.
 func (t *tokenStream) parseLicense() *node {
 	token := t.peek()
-	if token.role != licenseToken {
+	if token == nil || token.role != licenseToken {
 		return nil
 	}
+	start := t.index
 	t.next()
 
 	lic := licenseNodePartial{
@@ -346,22 +474,23 @@ func (t *tokenStream) parseLicense() *node {
 			if exception != nil {
 				lic.hasException = true
 				lic.exception = *exception
-				t.next()
 			}
 		}
 	}
 
-	return &(node{
+	node := &(node{
 		role: licenseNode,
 		lic:  &lic,
 	})
+	t.setSpan(node, start)
+	return node
 }
 
 // Return the operator's value (e.g. AND, OR, WITH) if the current token is an OPERATOR.
 // Advances the index if the operator is found.
 func (t *tokenStream) parseOperator(operator string) *string {
 	token := t.peek()
-	if token.role == operatorToken && token.value == operator {
+	if token != nil && token.role == operatorToken && token.value == operator {
 		t.next()
 		return &(token.value)
 	}
@@ -369,9 +498,9 @@ func (t *tokenStream) parseOperator(operator string) *string {
 	return nil
 }
 
-// Get the exception license when the WITH operator is found.
-// Return without advancing the index if the current token is not the WITH operator.
-// Raise an error if the WITH operator is not followed by and EXCEPTION license.
+// Get the exception license or custom addition when the WITH operator is found and advance
+// past it.  Return without advancing the index if the current token is not the WITH operator.
+// Raise an error if the WITH operator is not followed by an EXCEPTION license or AdditionRef.
 func (t *tokenStream) parseWith() *string {
 	operator := t.parseOperator("WITH")
 	if operator == nil {
@@ -379,13 +508,41 @@ func (t *tokenStream) parseWith() *string {
 		return nil
 	}
 
-	token := t.peek()
-	if token == nil || token.role != exceptionToken {
-		t.err = errors.New("expected exception after 'WITH'")
+	exception, ok := t.readAddition()
+	if !ok {
+		t.fail(MissingException, "expected exception after 'WITH'", expectedException, expectedAdditionRef)
 		return nil
 	}
+	return &exception
+}
 
-	return &(token.value)
+// Return the exception license or custom addition at the current token and advance past it.
+// A custom addition is returned as it is written in an expression (e.g. "AdditionRef-Custom",
+// "DocumentRef-spdx-tool-1.2:AdditionRef-Custom").  Returns false without advancing the index
+// if the current token does not start an exception license or custom addition.
+func (t *tokenStream) readAddition() (string, bool) {
+	token := t.peek()
+	if token == nil {
+		return "", false
+	}
+
+	switch token.role {
+	case exceptionToken:
+		t.index++
+		return token.value, true
+	case additionRefToken:
+		t.index++
+		return "AdditionRef-" + token.value, true
+	case documentRefToken:
+		if t.index+2 < len(t.tokens) &&
+			t.tokens[t.index+1].role == operatorToken && t.tokens[t.index+1].value == ":" &&
+			t.tokens[t.index+2].role == additionRefToken {
+			addition := "DocumentRef-" + token.value + ":AdditionRef-" + t.tokens[t.index+2].value
+			t.index += 3
+			return addition, true
+		}
+	}
+	return "", false
 }
 
 // Returns a human readable representation of the node tree.
@@ -397,6 +554,8 @@ func (n *node) string() string {
 		return licenseString(*n.lic)
 	case licenseRefNode:
 		return referenceString(*n.ref)
+	case specialValueNode:
+		return n.special
 	}
 	return ""
 }
@@ -425,5 +584,8 @@ func referenceString(ref referenceNodePartial) string {
 		s = "DocumentRef-" + ref.documentRef + ":"
 	}
 	s += "LicenseRef-" + ref.licenseRef
+	if ref.hasException {
+		s += " with " + ref.exception
+	}
 	return s
 }
@@ -0,0 +1,133 @@
+package spdxexp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseErrorKind identifies the category of problem reported by a ParseError.
+type ParseErrorKind uint8
+
+const (
+	// InvalidSyntax is a syntax error that does not fit one of the more specific kinds.
+	InvalidSyntax ParseErrorKind = iota
+	// EmptyExpression is an expression without any tokens.
+	EmptyExpression
+	// UnexpectedCharacter is a character that cannot start any token.
+	UnexpectedCharacter
+	// MissingIdentifier is a position where a license id or the id following a "DocumentRef-" or
+	// "LicenseRef-" prefix is expected, but none is found.
+	MissingIdentifier
+	// UnknownLicense is an identifier that is not in the SPDX license or exception lists.
+	UnknownLicense
+	// UnexpectedSpaceBeforePlus is whitespace between a license and the + operator.
+	UnexpectedSpaceBeforePlus
+	// UnbalancedParen is an open parenthesis without a matching close parenthesis or vice versa.
+	UnbalancedParen
+	// MissingOperand is an operator or parenthesis where a license or expression is expected.
+	MissingOperand
+	// MissingOperator is two licenses or expressions that are not separated by an operator.
+	MissingOperator
+	// MissingException is a WITH operator that is not followed by an exception.
+	MissingException
+	// MalformedReference is a "DocumentRef-" that is not followed by ":LicenseRef-" or
+	// ":AdditionRef-", or an "AdditionRef-" that does not follow WITH.
+	MalformedReference
+	// MisplacedSpecialValue is NONE or NOASSERTION in an expression with other licenses.  The
+	// special values are only valid as the entire expression.
+	MisplacedSpecialValue
+)
+
+// String returns the name of the kind.
+func (k ParseErrorKind) String() string {
+	switch k {
+	case InvalidSyntax:
+		return "InvalidSyntax"
+	case EmptyExpression:
+		return "EmptyExpression"
+	case UnexpectedCharacter:
+		return "UnexpectedCharacter"
+	case MissingIdentifier:
+		return "MissingIdentifier"
+	case UnknownLicense:
+		return "UnknownLicense"
+	case UnexpectedSpaceBeforePlus:
+		return "UnexpectedSpaceBeforePlus"
+	case UnbalancedParen:
+		return "UnbalancedParen"
+	case MissingOperand:
+		return "MissingOperand"
+	case MissingOperator:
+		return "MissingOperator"
+	case MissingException:
+		return "MissingException"
+	case MalformedReference:
+		return "MalformedReference"
+	case MisplacedSpecialValue:
+		return "MisplacedSpecialValue"
+	}
+	return "Unknown"
+}
+
+// Descriptions of the tokens that can be reported in ParseError.Expected.
+const (
+	expectedLicense     = "license"
+	expectedLicenseRef  = "LicenseRef"
+	expectedDocumentRef = "DocumentRef"
+	expectedException   = "exception"
+	expectedAdditionRef = "AdditionRef"
+	expectedID          = "id"
+)
+
+// ParseError describes why an expression could not be scanned or parsed.  Use errors.As to
+// retrieve a ParseError from the errors returned by Parse, Satisfies, and ExtractLicenses.
+type ParseError struct {
+	// Kind is the category of the error.
+	Kind ParseErrorKind
+	// Offset is the byte offset in the expression where the error was detected.  When the
+	// error is detected at the end of the expression, Offset is the length of the expression.
+	Offset int
+	// Token is the text of the offending token, or "" if the error is a missing token.
+	Token string
+	// Expected lists the tokens that would have been valid at Offset, if known.
+	Expected []string
+
+	msg      string
+	licenses *LicenseList // license list the ids were looked up in, used for suggestions
+}
+
+// Error returns a human readable description of the error.
+func (e *ParseError) Error() string {
+	return e.msg
+}
+
+// Suggestions returns up to five known license and exception ids that are similar to Token,
+// closest first, when Kind is UnknownLicense; otherwise, nil.  Ids that fix a common typo or
+// version pattern (e.g. "Apache-2.0" for "Apache2", "GPL-2.0-only" for "GPLv2") are first,
+// followed by ids ordered by edit distance, ignoring case.  Active licenses are preferred over
+// exceptions and deprecated licenses that are equally close.  Suggestions are from the license
+// list that the expression was parsed with, and are computed on each call.
+func (e *ParseError) Suggestions() []string {
+	if e.Kind != UnknownLicense {
+		return nil
+	}
+	return suggestLicenses(e.Token, e.licenses)
+}
+
+func newParseError(kind ParseErrorKind, offset int, token string, msg string, expected ...string) *ParseError {
+	return &ParseError{
+		Kind:     kind,
+		Offset:   offset,
+		Token:    token,
+		Expected: expected,
+		msg:      msg,
+	}
+}
+
+// Return a copy of the error reported at a different offset.
+func (e *ParseError) withOffset(offset int) *ParseError {
+	moved := *e
+	moved.Offset = offset
+	moved.msg = strings.Replace(e.msg, fmt.Sprintf("offset %d", e.Offset), fmt.Sprintf("offset %d", offset), 1)
+	return &moved
+}
@@ -0,0 +1,113 @@
+package spdxexp
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		kind       ParseErrorKind
+		offset     int
+		token      string
+		expected   []string
+		msg        string
+	}{
+		{"empty expression", "", EmptyExpression, 0, "", nil,
+			"parse error - cannot parse empty string"},
+		{"whitespace only", "   ", EmptyExpression, 3, "", nil,
+			"no tokens to parse"},
+		{"unknown license", "MIT OR Apache2", UnknownLicense, 7, "Apache2", []string{"license", "exception"},
+			"unknown license 'Apache2' at offset 7"},
+		{"character that cannot start an id", "MIT OR $", MissingIdentifier, 7, "$", []string{"id"},
+			"expected id at offset 7"},
+		{"missing id", "LicenseRef-!", MissingIdentifier, 11, "!", []string{"id"},
+			"expected id at offset 11"},
+		{"space before plus", "Apache-1.0 +", UnexpectedSpaceBeforePlus, 11, "+", nil,
+			"unexpected space before +"},
+		{"special value in expression", "MIT OR NONE", MisplacedSpecialValue, 7, "NONE", []string{"license", "LicenseRef", "DocumentRef", "("},
+			"NONE must be the entire expression"},
+		{"special value before license", "NOASSERTION MIT", MisplacedSpecialValue, 0, "NOASSERTION", []string{"license", "LicenseRef", "DocumentRef", "("},
+			"NOASSERTION must be the entire expression"},
+		{"missing close paren", "(MIT OR Apache-2.0", UnbalancedParen, 18, "", []string{")"},
+			"open parenthesis does not have a matching close parenthesis"},
+		{"missing open paren", "MIT OR Apache-2.0)", UnbalancedParen, 17, ")", []string{"AND", "OR"},
+			"close parenthesis does not have a matching open parenthesis"},
+		{"starts with close paren", ") MIT", UnbalancedParen, 0, ")", []string{"license", "LicenseRef", "DocumentRef", "("},
+			"expression starts with close parenthesis"},
+		{"missing operand at end", "MIT AND", MissingOperand, 7, "", []string{"license", "LicenseRef", "DocumentRef", "("},
+			"expected expression following AND, but found none"},
+		{"missing operand between operators", "MIT OR OR ISC", MissingOperand, 7, "OR", []string{"license", "LicenseRef", "DocumentRef", "("},
+			"expected license or expression, but found OR"},
+		{"missing operator", "MIT Apache-2.0", MissingOperator, 4, "Apache-2.0", []string{"AND", "OR"},
+			"licenses or expressions are not separated by an operator"},
+		{"missing exception", "GPL-2.0 WITH MIT", MissingException, 13, "MIT", []string{"exception", "AdditionRef"},
+			"expected exception after 'WITH'"},
+		{"only open paren", "(", MissingOperand, 1, "", []string{"license", "LicenseRef", "DocumentRef", "("},
+			"expected license or expression, but found none"},
+		{"open paren after AND", "MIT AND (", MissingOperand, 9, "", []string{"license", "LicenseRef", "DocumentRef", "("},
+			"expected license or expression, but found none"},
+		{"open paren after OR", "MIT OR (", MissingOperand, 8, "", []string{"license", "LicenseRef", "DocumentRef", "("},
+			"expected license or expression, but found none"},
+		{"exception without license", "Nokia-Qt-exception-1.1", InvalidSyntax, 0, "Nokia-Qt-exception-1.1", []string{"license", "LicenseRef", "DocumentRef", "("},
+			"expected license or expression, but found exception"},
+		{"document ref at end", "DocumentRef-x", MalformedReference, 13, "", []string{":"},
+			"expected ':' after 'DocumentRef-...'"},
+		{"document ref at end after AND", "MIT AND DocumentRef-x", MalformedReference, 21, "", []string{":"},
+			"expected ':' after 'DocumentRef-...'"},
+		{"missing license ref at end", "DocumentRef-spdx-tool-1.2:", MalformedReference, 26, "", []string{"LicenseRef"},
+			"expected 'LicenseRef-...' after 'DocumentRef-...'"},
+		{"missing license ref", "DocumentRef-spdx-tool-1.2:MIT", MalformedReference, 26, "MIT", []string{"LicenseRef"},
+			"expected 'LicenseRef-...' after 'DocumentRef-...'"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(test.expression)
+			require.Error(t, err)
+
+			var parseErr *ParseError
+			require.True(t, errors.As(err, &parseErr))
+			assert.Equal(t, test.kind, parseErr.Kind)
+			assert.Equal(t, test.offset, parseErr.Offset)
+			assert.Equal(t, test.token, parseErr.Token)
+			assert.Equal(t, test.expected, parseErr.Expected)
+			assert.Equal(t, test.msg, parseErr.Error())
+		})
+	}
+}
+
+func TestParseErrorWrapped(t *testing.T) {
+	_, err := Satisfies("MIT OR Apache2", []string{"MIT"})
+	wrapped := fmt.Errorf("checking dependency: %w", err)
+
+	var parseErr *ParseError
+	require.True(t, errors.As(wrapped, &parseErr))
+	assert.Equal(t, UnknownLicense, parseErr.Kind)
+	assert.Equal(t, "Apache2", parseErr.Token)
+}
+
+func TestParseErrorKindString(t *testing.T) {
+	assert.Equal(t, "UnknownLicense", UnknownLicense.String())
+	assert.Equal(t, "UnbalancedParen", UnbalancedParen.String())
+	assert.Equal(t, "MissingOperand", MissingOperand.String())
+	assert.Equal(t, "UnexpectedSpaceBeforePlus", UnexpectedSpaceBeforePlus.String())
+	assert.Equal(t, "MisplacedSpecialValue", MisplacedSpecialValue.String())
+}
+
+// requireEqualError requires that both errors are nil or that they have the same message.
+func requireEqualError(t *testing.T, expected, actual error) {
+	t.Helper()
+	if expected == nil {
+		require.NoError(t, actual)
+		return
+	}
+	require.EqualError(t, actual, expected.Error())
+}
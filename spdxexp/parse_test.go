@@ -916,7 +916,7 @@ func TestParse(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			startNode, err := parse(test.expression)
 
-			require.Equal(t, test.err, err)
+			requireEqualError(t, test.err, err)
 			if test.err != nil {
 				// when error, check that returned node is nil
 				var nilNode *node
@@ -1112,7 +1112,7 @@ func TestParseTokens(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			startNode := test.tokens.parseTokens()
 
-			require.Equal(t, test.err, test.tokens.err)
+			requireEqualError(t, test.err, test.tokens.err)
 			if test.err != nil {
 				// when error, check that returned node is nil
 				var nilNode *node
@@ -1185,7 +1185,7 @@ func TestNext(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			test.tokens.next()
 			assert.Equal(t, test.newIndex, test.tokens.index)
-			require.Equal(t, test.err, test.tokens.err)
+			requireEqualError(t, test.err, test.tokens.err)
 		})
 	}
 }
@@ -1243,7 +1243,9 @@ func TestParseWith(t *testing.T) {
 		newIndex  int
 		err       error
 	}{
-		{"WITH followed by EXCEPTION", getWithClauseTokens(1), "Bison-exception-2.2", false, 2, nil},
+		{"WITH followed by EXCEPTION", getWithClauseTokens(1), "Bison-exception-2.2", false, 3, nil},
+		{"WITH followed by AdditionRef", getWithAdditionRefTokens(1, false), "AdditionRef-Custom", false, 3, nil},
+		{"WITH followed by DocumentRef AdditionRef", getWithAdditionRefTokens(1, true), "DocumentRef-spdx-tool-1.2:AdditionRef-Custom", false, 5, nil},
 		{"WITH not followed by EXCEPTION", getInvalidWithClauseTokens(1), "", true, 2, errors.New("expected exception after 'WITH'")},
 		{"not with", getOrClauseTokens(1), "", true, 1, nil},
 		{"WITH not followed by any tokens", getMalformedWithClauseTokens(1), "", true, 2, errors.New("expected exception after 'WITH'")},
@@ -1255,7 +1257,7 @@ func TestParseWith(t *testing.T) {
 			exceptionLicense := test.tokens.parseWith()
 			assert.Equal(t, test.newIndex, test.tokens.index)
 
-			require.Equal(t, test.err, test.tokens.err)
+			requireEqualError(t, test.err, test.tokens.err)
 			if test.expectNil {
 				// exception license is nil when error occurs or WITH operator is not found
 				var nilString *string
@@ -1285,6 +1287,18 @@ func getWithClauseTokens(index int) *tokenStream {
 	return getTokenStream(tokens, index)
 }
 
+func getWithAdditionRefTokens(index int, withDocumentRef bool) *tokenStream {
+	var tokens []token
+	tokens = append(tokens, token{role: licenseRefToken, value: "MIT-Style-2"})
+	tokens = append(tokens, token{role: operatorToken, value: "WITH"})
+	if withDocumentRef {
+		tokens = append(tokens, token{role: documentRefToken, value: "spdx-tool-1.2"})
+		tokens = append(tokens, token{role: operatorToken, value: ":"})
+	}
+	tokens = append(tokens, token{role: additionRefToken, value: "Custom"})
+	return getTokenStream(tokens, index)
+}
+
 func getInvalidWithClauseTokens(index int) *tokenStream {
 	var tokens []token
 	tokens = append(tokens, token{role: licenseToken, value: "MIT"})
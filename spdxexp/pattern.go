@@ -0,0 +1,161 @@
+package spdxexp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// licensePattern is an entry in an allowed list with * wildcards, which match any sequence of
+// characters.  A pattern matches either SPDX license ids (e.g. "BSD-*") or license refs
+// (e.g. "LicenseRef-*", "DocumentRef-internal:*"), optionally with an exception or addition
+// pattern (e.g. "GPL-2.0-only WITH *").
+type licensePattern struct {
+	// entry is the pattern as it appears in the allowed list
+	entry string
+	// license is the pattern for the license id or the license ref, including its prefixes
+	license string
+	// isRef is true if the pattern matches license refs instead of license ids
+	isRef bool
+	// exception is the pattern for the exception or addition when hasException is true
+	exception    string
+	hasException bool
+	// isAddition is true if the exception pattern matches custom additions instead of exception ids
+	isAddition bool
+}
+
+// isPattern returns true if an allowed list entry is a pattern instead of an expression.
+func isPattern(entry string) bool {
+	return strings.Contains(entry, "*")
+}
+
+// parsePattern parses and validates a pattern.  Patterns for license ids and exception ids must
+// match at least one id in the SPDX license list, so that misspelled patterns are not silently
+// ignored.  Ids are looked up in the license list, or the default list if it is nil.
+func parsePattern(entry string, licenses *LicenseList) (*licensePattern, error) {
+	parts := strings.Fields(entry)
+	if len(parts) != 1 && (len(parts) != 3 || parts[1] != "WITH" && parts[1] != "with") {
+		return nil, errors.New("a pattern must be a single license, optionally WITH an exception, and cannot be combined with AND or OR")
+	}
+
+	p := &licensePattern{entry: entry, license: parts[0]}
+	p.isRef = strings.HasPrefix(p.license, "LicenseRef-") || strings.HasPrefix(p.license, "DocumentRef-")
+	if err := validatePatternCharacters(p.license, p.isRef); err != nil {
+		return nil, err
+	}
+	if !p.isRef && !patternMatchesAny(p.license, licenses.orDefault().licenses.ids, licenses.orDefault().deprecated.ids) {
+		return nil, fmt.Errorf("pattern '%s' does not match any SPDX license", p.license)
+	}
+
+	if len(parts) == 3 {
+		p.exception = parts[2]
+		p.hasException = true
+		p.isAddition = strings.HasPrefix(p.exception, "AdditionRef-") || strings.HasPrefix(p.exception, "DocumentRef-")
+		if err := validatePatternCharacters(p.exception, p.isAddition); err != nil {
+			return nil, err
+		}
+		if !p.isAddition && !patternMatchesAny(p.exception, licenses.orDefault().exceptions.ids, licenses.orDefault().deprecatedExceptions.ids) {
+			return nil, fmt.Errorf("pattern '%s' does not match any SPDX exception", p.exception)
+		}
+	}
+	return p, nil
+}
+
+// validatePatternCharacters checks that a pattern only has the characters of an id, and ':' when
+// it is a reference.
+func validatePatternCharacters(pattern string, isRef bool) error {
+	for _, c := range pattern {
+		if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '.' || c == '*' ||
+			isRef && c == ':' {
+			continue
+		}
+		return fmt.Errorf("unexpected character '%c' in pattern '%s'", c, pattern)
+	}
+	return nil
+}
+
+// patternMatchesAny returns true if the pattern matches any of the ids, ignoring case.
+func patternMatchesAny(pattern string, idLists ...[]string) bool {
+	for _, ids := range idLists {
+		for _, id := range ids {
+			if matchWildcards(pattern, id, true) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matches returns true if the pattern matches a license or license ref.  A license with + matches
+// when the pattern matches its id or any later version in its range (e.g. "GPL-2.0+" matches
+// "GPL-3.0*"), the same as a license with + is compatible with a later version in the allowed list.
+// Exceptions must match the exception pattern, and a pattern without an exception only matches
+// licenses without an exception.
+func (p *licensePattern) matches(n *node) bool {
+	if n.hasException() != p.hasException {
+		return false
+	}
+	if p.hasException && !matchWildcards(p.exception, *n.exception(), !p.isAddition) {
+		return false
+	}
+
+	switch {
+	case n.isLicenseRef():
+		if !p.isRef {
+			return false
+		}
+		ref := "LicenseRef-" + *n.licenseRef()
+		if n.hasDocumentRef() {
+			ref = "DocumentRef-" + *n.documentRef() + ":" + ref
+		}
+		return matchWildcards(p.license, ref, false)
+	case n.isLicense():
+		if p.isRef {
+			return false
+		}
+		if matchWildcards(p.license, *n.license(), true) {
+			return true
+		}
+		if n.hasPlus() {
+			for _, id := range laterLicenses(*n.license()) {
+				if matchWildcards(p.license, id, true) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// matchWildcards returns true if s matches the pattern, where * matches any sequence of characters.
+// When ignoreCase is true, ASCII letters are compared ignoring case.
+func matchWildcards(pattern, s string, ignoreCase bool) bool {
+	if ignoreCase {
+		pattern = strings.ToLower(pattern)
+		s = strings.ToLower(s)
+	}
+
+	// position after the last * in the pattern and the position in s that it is matched from, so
+	// that the * can match one more character when the rest of the pattern does not match
+	star, starMatch := -1, 0
+	p, i := 0, 0
+	for i < len(s) {
+		switch {
+		case p < len(pattern) && pattern[p] == '*':
+			p++
+			star, starMatch = p, i
+		case p < len(pattern) && pattern[p] == s[i]:
+			p++
+			i++
+		case star >= 0:
+			starMatch++
+			p, i = star, starMatch
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
@@ -0,0 +1,123 @@
+package spdxexp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		entry   string
+		pattern *licensePattern
+		err     error
+	}{
+		{"license id", "BSD-*", &licensePattern{entry: "BSD-*", license: "BSD-*"}, nil},
+		{"license ref", "LicenseRef-*", &licensePattern{entry: "LicenseRef-*", license: "LicenseRef-*", isRef: true}, nil},
+		{"document ref", "DocumentRef-internal:*", &licensePattern{entry: "DocumentRef-internal:*", license: "DocumentRef-internal:*", isRef: true}, nil},
+		{"any exception", "GPL-2.0-only WITH *", &licensePattern{entry: "GPL-2.0-only WITH *", license: "GPL-2.0-only",
+			exception: "*", hasException: true}, nil},
+		{"license and exception", "GPL-* with Classpath-*", &licensePattern{entry: "GPL-* with Classpath-*", license: "GPL-*",
+			exception: "Classpath-*", hasException: true}, nil},
+		{"addition", "LicenseRef-* WITH AdditionRef-*", &licensePattern{entry: "LicenseRef-* WITH AdditionRef-*", license: "LicenseRef-*",
+			isRef: true, exception: "AdditionRef-*", hasException: true, isAddition: true}, nil},
+		{"no matching license", "NOT-A-LICENSE-*", nil, errors.New("pattern 'NOT-A-LICENSE-*' does not match any SPDX license")},
+		{"no matching exception", "GPL-2.0-only WITH NOT-AN-EXCEPTION-*", nil,
+			errors.New("pattern 'NOT-AN-EXCEPTION-*' does not match any SPDX exception")},
+		{"unexpected character", "BSD-*+", nil, errors.New("unexpected character '+' in pattern 'BSD-*+'")},
+		{"colon in license id", "BSD:*", nil, errors.New("unexpected character ':' in pattern 'BSD:*'")},
+		{"combined with OR", "BSD-* OR MIT", nil,
+			errors.New("a pattern must be a single license, optionally WITH an exception, and cannot be combined with AND or OR")},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			pattern, err := parsePattern(test.entry, nil)
+			requireEqualError(t, test.err, err)
+			assert.Equal(t, test.pattern, pattern)
+		})
+	}
+}
+
+func TestMatchWildcards(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		s          string
+		ignoreCase bool
+		matches    bool
+	}{
+		{"*", "", false, true},
+		{"*", "MIT", false, true},
+		{"MIT", "MIT", false, true},
+		{"MIT", "mit", false, false},
+		{"MIT", "mit", true, true},
+		{"BSD-*", "BSD-3-Clause", false, true},
+		{"BSD-*", "0BSD", false, false},
+		{"*-Clause", "BSD-3-Clause", false, true},
+		{"BSD-*-Clause", "BSD-3-Clause-Clear", false, false},
+		{"BSD-*-Clause*", "BSD-3-Clause-Clear", false, true},
+		{"CC-BY-*", "CC-BY-SA-4.0", false, true},
+		{"CC-BY-*", "CC-BY", false, false},
+		{"a*b*c", "aXbYbZc", false, true},
+		{"a*b*c", "aXbYcZ", false, false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.pattern+" "+test.s, func(t *testing.T) {
+			assert.Equal(t, test.matches, matchWildcards(test.pattern, test.s, test.ignoreCase))
+		})
+	}
+}
+
+func TestSatisfies_Patterns(t *testing.T) {
+	tests := []struct {
+		name           string
+		testExpression string
+		allowedList    []string
+		satisfied      bool
+	}{
+		{"license id", "BSD-3-Clause", []string{"BSD-*"}, true},
+		{"license id not matched", "0BSD", []string{"BSD-*"}, false},
+		{"license id ignores case", "cc-by-4.0", []string{"CC-BY-*"}, true},
+		{"license id with exception not matched", "BSD-3-Clause WITH Classpath-exception-2.0", []string{"BSD-*"}, false},
+		{"MIT", "MIT", []string{"MI*"}, true},
+		{"expression", "BSD-2-Clause AND (GPL-2.0 OR CC-BY-SA-4.0)", []string{"BSD-*", "CC-BY-*"}, true},
+		{"plus matches later version", "GPL-2.0+", []string{"GPL-3.0*"}, true},
+		{"no plus does not match later version", "GPL-2.0", []string{"GPL-3.0*"}, false},
+		{"any exception", "GPL-2.0-only WITH Classpath-exception-2.0", []string{"GPL-2.0-only WITH *"}, true},
+		{"any exception requires an exception", "GPL-2.0-only", []string{"GPL-2.0-only WITH *"}, false},
+		{"exception pattern", "GPL-3.0-only WITH GCC-exception-3.1", []string{"GPL-* WITH GCC-*"}, true},
+		{"exception pattern not matched", "GPL-3.0-only WITH Bison-exception-2.2", []string{"GPL-* WITH GCC-*"}, false},
+		{"license ref", "LicenseRef-Internal", []string{"LicenseRef-*"}, true},
+		{"license ref does not match document ref", "DocumentRef-internal:LicenseRef-X", []string{"LicenseRef-*"}, false},
+		{"document ref", "DocumentRef-internal:LicenseRef-X", []string{"DocumentRef-internal:*"}, true},
+		{"other document ref", "DocumentRef-external:LicenseRef-X", []string{"DocumentRef-internal:*"}, false},
+		{"license ref is case sensitive", "LicenseRef-internal-x", []string{"LicenseRef-Internal-*"}, false},
+		{"addition", "LicenseRef-X WITH AdditionRef-Custom", []string{"LicenseRef-* WITH AdditionRef-*"}, true},
+		{"license pattern does not match license ref", "LicenseRef-MIT", []string{"M*"}, false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			satisfied, err := Satisfies(test.testExpression, test.allowedList)
+			require.NoError(t, err)
+			assert.Equal(t, test.satisfied, satisfied)
+		})
+	}
+}
+
+func TestCompileAllowList_Patterns(t *testing.T) {
+	_, err := CompileAllowList([]string{"MIT", "BSD-* AND MIT"})
+	requireEqualError(t, errors.New("invalid allowedList entry \"BSD-* AND MIT\" at index 1: "+
+		"a pattern must be a single license, optionally WITH an exception, and cannot be combined with AND or OR"), err)
+
+	result, err := SatisfiesDetailed("BSD-3-Clause AND MIT", []string{"MIT", "BSD-*"}, SatisfiesOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []LicenseMatch{{License: "BSD-3-Clause", Allowed: "BSD-*"}, {License: "MIT", Allowed: "MIT"}}, result.Branches[0].Matches)
+}
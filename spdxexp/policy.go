@@ -0,0 +1,175 @@
+package spdxexp
+
+import "strings"
+
+// Verdict is the result of evaluating a license expression against a Policy.  Verdicts are
+// ordered from best to worst, so a smaller verdict is better.
+type Verdict uint8
+
+const (
+	// Allowed means the expression can be used without review.
+	Allowed Verdict = iota
+	// NeedsReview means the expression can only be used after it is reviewed.
+	NeedsReview
+	// Denied means the expression cannot be used.
+	Denied
+)
+
+// String returns the name of the verdict.
+func (v Verdict) String() string {
+	switch v {
+	case Allowed:
+		return "Allowed"
+	case NeedsReview:
+		return "NeedsReview"
+	case Denied:
+		return "Denied"
+	}
+	return "Unknown"
+}
+
+// PolicyLists are the lists of licenses that define a Policy.  Each list has the same format as the
+// allowed list of Satisfies, including expressions, and any of them can be empty.
+type PolicyLists struct {
+	// Allowed licenses can be used without review.
+	Allowed []string
+	// Review licenses can only be used after they are reviewed.
+	Review []string
+	// Denied licenses cannot be used.
+	Denied []string
+	// LicenseList is the license list that licenses in the lists and in evaluated expressions are
+	// looked up in.  When nil, the list embedded in the spdxlicenses package is used.  License
+	// ranges, including the versions of a license with a +, are always from the embedded list.
+	LicenseList *LicenseList
+}
+
+// Policy evaluates license expressions against lists of allowed, review-required, and denied
+// licenses, using the same compatibility rules as Satisfies (e.g. "Apache-2.0" is allowed by
+// "Apache-1.0+").  A Policy is not changed after it is compiled, so it is safe for concurrent use.
+type Policy struct {
+	allowed  *AllowList
+	review   *AllowList
+	denied   *AllowList
+	licenses *LicenseList
+}
+
+// CompilePolicy parses and validates each license in the policy lists.
+// Returns an *AllowListError identifying the first invalid entry.
+func CompilePolicy(lists PolicyLists) (*Policy, error) {
+	allowed, err := compileLicenseList("allowed", lists.Allowed, lists.LicenseList)
+	if err != nil {
+		return nil, err
+	}
+	review, err := compileLicenseList("review", lists.Review, lists.LicenseList)
+	if err != nil {
+		return nil, err
+	}
+	denied, err := compileLicenseList("denied", lists.Denied, lists.LicenseList)
+	if err != nil {
+		return nil, err
+	}
+	return &Policy{allowed: allowed, review: review, denied: denied, licenses: lists.LicenseList}, nil
+}
+
+// Evaluate returns the verdict of the policy for a license expression.
+//
+// Each license is Denied if it is in the denied list, NeedsReview if it is in the review list, and
+// Allowed if it is in the allowed list, in that order, so the denied list takes precedence (e.g.
+// "Apache-1.1" is Denied when "Apache-1.0+" is allowed and "Apache-1.1" is denied).  Licenses that
+// are not in any list need review.  A license with a + (or an -or-later id) is a choice between
+// the versions in its range, so it gets the best verdict of those versions (e.g. "GPL-2.0-or-later"
+// is Allowed when "GPL-2.0-only" is allowed and "GPL-3.0-only" is denied).
+//
+// Each ORed branch of the expression gets the worst verdict of its ANDed licenses, and the
+// expression gets the best verdict of its branches.  So, an expression is Allowed if any branch is
+// fully allowed, NeedsReview if any branch has no denied licenses, and Denied only when every
+// branch has a denied license.
+// Returns error if the expression cannot be parsed.
+func (p *Policy) Evaluate(expression string) (Verdict, error) {
+	n, err := parseWithLicenses(strings.TrimSpace(expression), p.licenses)
+	if err != nil {
+		return Denied, err
+	}
+	n = expandLaterVersions(n)
+
+	if len(p.allowed.bundles) == 0 && len(p.review.bundles) == 0 && len(p.denied.bundles) == 0 {
+		// without bundles, each license has the same verdict in every branch, so the expression
+		// tree is evaluated directly instead of expanding it into branches
+		return p.evaluateNode(n, map[leafKey]Verdict{}), nil
+	}
+
+	// bundles depend on the other licenses in a branch, so the bundles that can be complete in the
+	// expression are evaluated with the states of its branches
+	return newBundleEvaluator(n, NeedsReview, p.denied, p.review, p.allowed).evaluate(n), nil
+}
+
+// evaluateNode returns the best verdict of the sides of an OR expression, the worst verdict of the
+// sides of an AND expression, or the verdict of a license.  Verdicts of licenses are memoized.
+func (p *Policy) evaluateNode(n *node, verdicts map[leafKey]Verdict) Verdict {
+	if n.isExpression() {
+		left := p.evaluateNode(n.left(), verdicts)
+		if n.isOrExpression() {
+			if left == Allowed {
+				return Allowed
+			}
+			return min(left, p.evaluateNode(n.right(), verdicts))
+		}
+		if left == Denied {
+			return Denied
+		}
+		return max(left, p.evaluateNode(n.right(), verdicts))
+	}
+
+	key := newLeafKey(n)
+	if verdict, ok := verdicts[key]; ok {
+		return verdict
+	}
+	verdict := licenseVerdict(p.denied.isAllowed(n), p.review.isAllowed(n), p.allowed.isAllowed(n))
+	verdicts[key] = verdict
+	return verdict
+}
+
+// licenseVerdict returns the verdict of a license given the lists that it is in.
+func licenseVerdict(denied, review, allowed bool) Verdict {
+	switch {
+	case denied:
+		return Denied
+	case review:
+		return NeedsReview
+	case allowed:
+		return Allowed
+	}
+	return NeedsReview
+}
+
+// expandLaterVersions replaces each license with a + in a range by an OR expression of the versions
+// in its range (e.g. "GPL-2.0+" is "GPL-2.0 OR GPL-2.0-only OR GPL-3.0 OR GPL-3.0-only"), since the
+// license can be used under any of them.  Nodes without such licenses are returned unchanged.
+func expandLaterVersions(n *node) *node {
+	if n.isExpression() {
+		left, right := expandLaterVersions(n.left()), expandLaterVersions(n.right())
+		if left == n.left() && right == n.right() {
+			return n
+		}
+		return joinNodes(left, *n.conjunction(), right)
+	}
+	if !n.isLicense() || !n.hasPlus() {
+		return n
+	}
+
+	var expanded *node
+	for _, id := range laterLicenses(*n.license()) {
+		if strings.HasSuffix(id, "-or-later") {
+			// the -only and deprecated ids already cover the version
+			continue
+		}
+		version := *n.lic
+		version.license = id
+		version.hasPlus = false
+		expanded = joinNodes(expanded, "or", &node{role: licenseNode, lic: &version})
+	}
+	if expanded == nil {
+		return n
+	}
+	return expanded
+}
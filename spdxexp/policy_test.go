@@ -0,0 +1,160 @@
+package spdxexp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyEvaluate(t *testing.T) {
+	policy, err := CompilePolicy(PolicyLists{
+		Allowed: []string{"MIT", "Apache-1.0+", "LicenseRef-X", "NOASSERTION"},
+		Review:  []string{"LGPL-2.1-or-later", "GPL-2.0-only WITH Classpath-exception-2.0"},
+		Denied:  []string{"GPL-2.0-only", "AGPL-3.0-only", "Apache-1.1"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		expression string
+		verdict    Verdict
+		err        error
+	}{
+		{"allowed", "MIT", Allowed, nil},
+		{"allowed by range", "Apache-2.0", Allowed, nil},
+		{"denied takes precedence over allowed range", "Apache-1.1", Denied, nil},
+		{"review", "LGPL-3.0-only", NeedsReview, nil},
+		{"review with exception", "GPL-2.0 WITH Classpath-exception-2.0", NeedsReview, nil},
+		{"denied without exception", "GPL-2.0", Denied, nil},
+		{"unlisted needs review", "ISC", NeedsReview, nil},
+		{"license ref", "LicenseRef-X", Allowed, nil},
+		{"special value", "noassertion", Allowed, nil},
+		{"unlisted special value", "NONE", NeedsReview, nil},
+		{"AND is the worst verdict", "MIT AND LGPL-2.1-or-later", NeedsReview, nil},
+		{"AND with denied", "MIT AND LGPL-2.1-or-later AND AGPL-3.0-only", Denied, nil},
+		{"OR prefers allowed", "AGPL-3.0-only OR LGPL-2.1-or-later OR MIT", Allowed, nil},
+		{"OR falls back to review", "AGPL-3.0-only OR LGPL-2.1-or-later", NeedsReview, nil},
+		{"OR denied when every branch is denied", "AGPL-3.0-only OR (MIT AND GPL-2.0-only)", Denied, nil},
+		{"nested", "(MIT OR AGPL-3.0-only) AND (GPL-2.0-only OR Apache-2.0)", Allowed, nil},
+		{"invalid expression", "MIT OR", Denied, errors.New("expected expression following OR, but found none")},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			verdict, err := policy.Evaluate(test.expression)
+			requireEqualError(t, test.err, err)
+			assert.Equal(t, test.verdict, verdict)
+		})
+	}
+}
+
+func TestPolicyEvaluate_LaterVersions(t *testing.T) {
+	tests := []struct {
+		name       string
+		lists      PolicyLists
+		expression string
+		verdict    Verdict
+	}{
+		{"allowed version", PolicyLists{Allowed: []string{"GPL-2.0-only"}, Denied: []string{"GPL-3.0-only"}}, "GPL-2.0-or-later", Allowed},
+		{"allowed version with +", PolicyLists{Allowed: []string{"GPL-2.0-only"}, Denied: []string{"GPL-3.0-only"}}, "GPL-2.0+", Allowed},
+		{"allowed later version", PolicyLists{Allowed: []string{"GPL-3.0-only"}, Denied: []string{"GPL-2.0-only"}}, "GPL-2.0-or-later", Allowed},
+		{"every version denied", PolicyLists{Denied: []string{"GPL-2.0-only", "GPL-3.0-only"}}, "GPL-2.0-or-later", Denied},
+		{"or-later denied", PolicyLists{Allowed: []string{"MIT"}, Denied: []string{"GPL-2.0-or-later"}}, "GPL-2.0-or-later", Denied},
+		{"unlisted version needs review", PolicyLists{Denied: []string{"GPL-3.0-only"}}, "GPL-2.0-or-later", NeedsReview},
+		{"earlier version is not a choice", PolicyLists{Allowed: []string{"GPL-2.0-only"}}, "GPL-3.0-or-later", NeedsReview},
+		{"version with exception", PolicyLists{Allowed: []string{"GPL-2.0-only WITH Classpath-exception-2.0"}, Denied: []string{"GPL-3.0-only"}}, "GPL-2.0+ WITH Classpath-exception-2.0", Allowed},
+		{"version in bundle", PolicyLists{Allowed: []string{"GPL-2.0-only AND MIT"}, Denied: []string{"GPL-3.0-only"}}, "GPL-2.0-or-later AND MIT", Allowed},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			policy, err := CompilePolicy(test.lists)
+			require.NoError(t, err)
+			verdict, err := policy.Evaluate(test.expression)
+			require.NoError(t, err)
+			assert.Equal(t, test.verdict, verdict)
+		})
+	}
+}
+
+func TestPolicyEvaluate_Bundles(t *testing.T) {
+	policy, err := CompilePolicy(PolicyLists{
+		Allowed: []string{"MIT", "Apache-2.0 AND ISC"},
+		Review:  []string{"BSD-2-Clause AND 0BSD"},
+		Denied:  []string{"GPL-2.0-only AND Apache-2.0"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		expression string
+		verdict    Verdict
+	}{
+		{"allowed bundle", "ISC AND Apache-2.0 AND MIT", Allowed},
+		{"incomplete allowed bundle", "Apache-2.0 AND MIT", NeedsReview},
+		{"review bundle", "BSD-2-Clause AND 0BSD AND MIT", NeedsReview},
+		{"denied bundle", "GPL-2.0-only AND Apache-2.0 AND ISC", Denied},
+		{"incomplete denied bundle", "GPL-2.0-only", NeedsReview},
+		{"OR prefers allowed bundle", "(GPL-2.0-only AND Apache-2.0) OR (Apache-2.0 AND ISC)", Allowed},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			verdict, err := policy.Evaluate(test.expression)
+			require.NoError(t, err)
+			assert.Equal(t, test.verdict, verdict)
+		})
+	}
+}
+
+func TestPolicyEvaluate_BundlesWithManyANDedORs(t *testing.T) {
+	policy, err := CompilePolicy(PolicyLists{
+		Allowed: []string{"MIT", "LicenseRef-*", "Apache-2.0 AND ISC"},
+		Review:  []string{"BSD-2-Clause AND 0BSD"},
+		Denied:  []string{"GPL-2.0-only AND Apache-2.0"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		expression string
+		verdict    Verdict
+	}{
+		{"unrelated bundles", manyANDedORs(20), Allowed},
+		{"allowed bundle", manyANDedORs(20) + " AND (Apache-2.0 OR GPL-2.0-only) AND ISC", Allowed},
+		{"review bundle", manyANDedORs(20) + " AND BSD-2-Clause AND (0BSD OR GPL-2.0-only)", NeedsReview},
+		{"denied bundle", manyANDedORs(20) + " AND GPL-2.0-only AND Apache-2.0", Denied},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			verdict, err := policy.Evaluate(test.expression)
+			require.NoError(t, err)
+			assert.Equal(t, test.verdict, verdict)
+		})
+	}
+}
+
+func TestCompilePolicy(t *testing.T) {
+	policy, err := CompilePolicy(PolicyLists{})
+	require.NoError(t, err)
+	verdict, err := policy.Evaluate("MIT")
+	require.NoError(t, err)
+	assert.Equal(t, NeedsReview, verdict)
+
+	_, err = CompilePolicy(PolicyLists{Allowed: []string{"MIT"}, Denied: []string{"GPL-2.0", "Apache2"}})
+	requireEqualError(t, errors.New("invalid denied entry \"Apache2\" at index 1: unknown license 'Apache2' at offset 0"), err)
+}
+
+func TestVerdictString(t *testing.T) {
+	assert.Equal(t, "Allowed", Allowed.String())
+	assert.Equal(t, "NeedsReview", NeedsReview.String())
+	assert.Equal(t, "Denied", Denied.String())
+	assert.Equal(t, "Unknown", Verdict(99).String())
+}
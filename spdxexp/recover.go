@@ -0,0 +1,376 @@
+package spdxexp
+
+import (
+	"sort"
+	"strings"
+)
+
+// ParseOptions controls how ParseWithOptions parses an expression.
+type ParseOptions struct {
+	// Recover continues parsing after an error instead of stopping at the first one.  The parser
+	// skips text it cannot scan and resynchronizes at operators and parentheses, so that every
+	// problem in the expression is reported.  The returned expression is built from the parts
+	// that could be parsed.  Licenses that are not separated by an operator are joined with AND.
+	Recover bool
+
+	// LicenseList is the license list that license and exception ids are looked up in.  When nil,
+	// the list embedded in the spdxlicenses package is used.
+	LicenseList *LicenseList
+}
+
+// ParseErrors is the error returned by ParseWithOptions when Recover is set.  It holds every
+// problem found in the expression ordered by offset.
+type ParseErrors []*ParseError
+
+// Error returns the messages of all errors separated by "; ".
+func (e ParseErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the individual errors so that errors.As can find the first ParseError.
+func (e ParseErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// ParseWithOptions parses an SPDX license expression as controlled by options.
+//
+// Without options.Recover, ParseWithOptions behaves the same as Parse, looking up ids in
+// options.LicenseList.  With options.Recover,
+// ParseWithOptions returns the partial expression that could be parsed, which may be nil, and
+// when there are problems, a ParseErrors error listing all of them.
+func ParseWithOptions(expression string, options ParseOptions) (*Expression, error) {
+	if !options.Recover {
+		n, spans, err := parseWithSpans(expression, options.LicenseList)
+		if err != nil {
+			return nil, err
+		}
+		return newExpression(n, spans), nil
+	}
+
+	n, spans, errs := parseRecovering(expression, options.LicenseList)
+	if len(errs) > 0 {
+		return newExpression(n, spans), errs
+	}
+	return newExpression(n, spans), nil
+}
+
+// recoveringParser parses tokens produced by scanRecovering.  Instead of stopping at the first
+// error, it records the error and resynchronizes at the next operator or parenthesis.
+type recoveringParser struct {
+	tokenStream
+	errs    []*ParseError
+	depth   int            // number of open parentheses enclosing the current token
+	extents map[*node]Span // span of each node including enclosing parentheses
+}
+
+func parseRecovering(source string, licenses *LicenseList) (*node, map[*node]Span, ParseErrors) {
+	if len(source) == 0 {
+		return nil, nil, ParseErrors{newParseError(EmptyExpression, 0, "", "parse error - cannot parse empty string")}
+	}
+
+	tokens, scanErrs := scanRecovering(source, licenses)
+	p := &recoveringParser{
+		tokenStream: tokenStream{tokens: tokens, index: 0, err: nil, end: len(source), spans: map[*node]Span{}},
+		extents:     map[*node]Span{},
+	}
+	if len(tokens) == 0 {
+		if len(scanErrs) == 0 {
+			p.record(EmptyExpression, "no tokens to parse")
+		}
+		return nil, nil, append(ParseErrors(scanErrs), p.errs...)
+	}
+	if len(tokens) == 1 && tokens[0].role == specialValueToken {
+		// NONE and NOASSERTION are only valid as the entire expression
+		p.skip()
+		return p.leaf(&node{role: specialValueNode, special: tokens[0].value}, 0), p.spans, ParseErrors(scanErrs)
+	}
+
+	root := p.parseSequence(false)
+
+	errs := append(ParseErrors(scanErrs), p.errs...)
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].Offset < errs[j].Offset
+	})
+	return root, p.spans, errs
+}
+
+// Record an error at the current token.
+func (p *recoveringParser) record(kind ParseErrorKind, msg string, expected ...string) {
+	p.fail(kind, msg, expected...)
+	p.errs = append(p.errs, p.err.(*ParseError))
+	p.err = nil
+}
+
+// Advance past the current token if there is one.
+func (p *recoveringParser) skip() {
+	if p.hasMore() {
+		p.index++
+	}
+}
+
+// Advance past the current token and return true if it is the operator; otherwise, return false.
+func (p *recoveringParser) accept(operator string) bool {
+	if !p.peekOperator(operator) {
+		return false
+	}
+	p.skip()
+	return true
+}
+
+// Return true if the current token is an invalid token or follows an invalid token.  Errors
+// caused by the position of an invalid token are not reported, since the scanner already
+// reported the invalid token.
+func (p *recoveringParser) nearInvalid() bool {
+	if token := p.peek(); token != nil && token.role == invalidToken {
+		return true
+	}
+	return p.index > 0 && p.tokens[p.index-1].role == invalidToken
+}
+
+// Parse OR expressions until the end of the tokens or, when inParen is true, until a close
+// parenthesis.  Expressions that are not separated by an operator are joined with AND.
+func (p *recoveringParser) parseSequence(inParen bool) *node {
+	result := p.parseOr()
+	skipped := false // true if the previous token was skipped after reporting an error
+	for p.hasMore() {
+		switch {
+		case p.peekOperator(")"):
+			if inParen {
+				return result
+			}
+			p.record(UnbalancedParen, "close parenthesis does not have a matching open parenthesis", "AND", "OR")
+			p.skip()
+			skipped = true
+			continue
+		case p.peekOperator("AND"), p.peekOperator("OR"):
+			// only reached after skipping a token
+			conjunction := strings.ToLower(p.peek().value)
+			p.skip()
+			result = p.join(result, conjunction, p.parseOr())
+		case p.startsAtom():
+			if !skipped && !p.nearInvalid() {
+				p.record(MissingOperator, "licenses or expressions are not separated by an operator", "AND", "OR")
+			}
+			result = p.join(result, "and", p.parseOr())
+		default:
+			// stray operator (e.g. WITH, :, +)
+			p.record(InvalidSyntax, "syntax error", "AND", "OR")
+			p.skip()
+			skipped = true
+			continue
+		}
+		skipped = false
+	}
+	return result
+}
+
+// Return true if the current token can start a license or expression.
+func (p *recoveringParser) startsAtom() bool {
+	token := p.peek()
+	if token == nil {
+		return false
+	}
+	return token.role != operatorToken || token.value == "("
+}
+
+func (p *recoveringParser) parseOr() *node {
+	result := p.parseAnd()
+	for p.peekOperator("OR") {
+		p.skip()
+		result = p.join(result, "or", p.parseAnd())
+	}
+	return result
+}
+
+func (p *recoveringParser) parseAnd() *node {
+	result := p.parseAtom()
+	for p.peekOperator("AND") {
+		p.skip()
+		result = p.join(result, "and", p.parseAtom())
+	}
+	return result
+}
+
+func (p *recoveringParser) parseAtom() *node {
+	token := p.peek()
+	if token == nil {
+		p.record(MissingOperand, "expected license or expression, but found none", atomExpected...)
+		return nil
+	}
+
+	switch token.role {
+	case invalidToken:
+		// already reported by the scanner
+		p.skip()
+		return nil
+	case licenseToken:
+		return p.parseRecoveringLicense()
+	case documentRefToken, licenseRefToken:
+		return p.parseRecoveringLicenseRef()
+	case exceptionToken:
+		p.record(InvalidSyntax, "expected license or expression, but found exception", atomExpected...)
+		p.skip()
+		return nil
+	case additionRefToken:
+		p.record(MalformedReference, "'AdditionRef-...' is only allowed after 'WITH'", atomExpected...)
+		p.skip()
+		return nil
+	case specialValueToken:
+		p.record(MisplacedSpecialValue, token.value+" must be the entire expression", atomExpected...)
+		p.skip()
+		return nil
+	}
+
+	switch token.value {
+	case "(":
+		start := p.index
+		p.skip()
+		p.depth++
+		expr := p.parseSequence(true)
+		p.depth--
+		if !p.accept(")") {
+			p.record(UnbalancedParen, "open parenthesis does not have a matching close parenthesis", ")")
+		}
+		if expr != nil {
+			p.extents[expr] = Span{Start: p.tokens[start].start, End: p.tokens[p.index-1].end}
+		}
+		return expr
+	case "AND", "OR", ")":
+		// leave the token for the caller to resynchronize on; an unmatched close parenthesis
+		// is reported by parseSequence
+		unmatched := token.value == ")" && p.depth == 0
+		if !unmatched && !p.nearInvalid() {
+			found := token.value
+			if found == ")" {
+				found = "close parenthesis"
+			}
+			p.record(MissingOperand, "expected license or expression, but found "+found, atomExpected...)
+		}
+		return nil
+	}
+
+	// stray WITH, :, or +
+	p.record(InvalidSyntax, "syntax error", atomExpected...)
+	p.skip()
+	return nil
+}
+
+func (p *recoveringParser) parseRecoveringLicense() *node {
+	start := p.index
+	token := p.peek()
+	p.skip()
+
+	lic := licenseNodePartial{license: token.value}
+	if strings.HasSuffix(token.value, "-or-later") {
+		lic.hasPlus = true
+	}
+	if p.accept("+") {
+		lic.hasPlus = true
+	}
+	lic.exception, lic.hasException = p.parseRecoveringWith()
+
+	return p.leaf(&node{role: licenseNode, lic: &lic}, start)
+}
+
+// Parse an optional WITH clause.  Returns the exception and true if there is a valid one.
+func (p *recoveringParser) parseRecoveringWith() (string, bool) {
+	if !p.accept("WITH") {
+		return "", false
+	}
+	if exception, ok := p.readAddition(); ok {
+		return exception, true
+	}
+
+	token := p.peek()
+	switch {
+	case token != nil && token.role == invalidToken:
+		// already reported by the scanner
+		p.skip()
+	case token != nil && token.role != operatorToken:
+		p.record(MissingException, "expected exception after 'WITH'", expectedException, expectedAdditionRef)
+		p.skip()
+	default:
+		p.record(MissingException, "expected exception after 'WITH'", expectedException, expectedAdditionRef)
+	}
+	return "", false
+}
+
+func (p *recoveringParser) parseRecoveringLicenseRef() *node {
+	ref := referenceNodePartial{}
+	start := p.index
+
+	if token := p.peek(); token != nil && token.role == documentRefToken {
+		ref.documentRef = token.value
+		ref.hasDocumentRef = true
+		p.skip()
+
+		if !p.accept(":") {
+			p.record(MalformedReference, "expected ':' after 'DocumentRef-...'", ":")
+			return nil
+		}
+	}
+
+	token := p.peek()
+	if token != nil && token.role == additionRefToken {
+		p.record(MalformedReference, "'DocumentRef-...:AdditionRef-...' is only allowed after 'WITH'", atomExpected...)
+		p.skip()
+		return nil
+	}
+	if token == nil || token.role != licenseRefToken {
+		if !p.nearInvalid() {
+			p.record(MalformedReference, "expected 'LicenseRef-...' after 'DocumentRef-...'", expectedLicenseRef)
+		}
+		return nil
+	}
+	ref.licenseRef = token.value
+	p.skip()
+	ref.exception, ref.hasException = p.parseRecoveringWith()
+
+	return p.leaf(&node{role: licenseRefNode, ref: &ref}, start)
+}
+
+// Record the span of a leaf node parsed from the token at index start through the last consumed
+// token.
+func (p *recoveringParser) leaf(n *node, start int) *node {
+	p.setSpan(n, start)
+	p.extents[n] = p.spans[n]
+	return n
+}
+
+// Join two nodes with a conjunction.  The span of a new node extends from the start of the left
+// node to the end of the right node, including any parentheses around them.
+func (p *recoveringParser) join(left *node, conjunction string, right *node) *node {
+	n := joinNodes(left, conjunction, right)
+	if n != left && n != right {
+		span := Span{Start: p.extents[left].Start, End: p.extents[right].End}
+		p.spans[n] = span
+		p.extents[n] = span
+	}
+	return n
+}
+
+// joinNodes joins two nodes with a conjunction.  If either node is nil, the other is returned.
+func joinNodes(left *node, conjunction string, right *node) *node {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	return &node{
+		role: expressionNode,
+		exp: &expressionNodePartial{
+			left:        left,
+			conjunction: conjunction,
+			right:       right,
+		},
+	}
+}
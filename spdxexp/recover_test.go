@@ -0,0 +1,114 @@
+package spdxexp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWithOptionsRecover(t *testing.T) {
+	type diagnostic struct {
+		kind   ParseErrorKind
+		offset int
+		token  string
+	}
+	tests := []struct {
+		name        string
+		expression  string
+		partial     string
+		diagnostics []diagnostic
+	}{
+		{"valid expression", "MIT OR (Apache-2.0 AND ISC)", "MIT OR Apache-2.0 AND ISC", nil},
+		{"multiple problems", "MIT ANDD (Apache-2.0 OR FOO", "MIT AND Apache-2.0",
+			[]diagnostic{
+				{UnknownLicense, 4, "ANDD"},
+				{UnknownLicense, 24, "FOO"},
+				{UnbalancedParen, 27, ""},
+			}},
+		{"empty expression", "", "",
+			[]diagnostic{{EmptyExpression, 0, ""}}},
+		{"whitespace only", "   ", "",
+			[]diagnostic{{EmptyExpression, 3, ""}}},
+		{"missing operand at end", "MIT AND", "MIT",
+			[]diagnostic{{MissingOperand, 7, ""}}},
+		{"missing operand at start", "OR MIT", "MIT",
+			[]diagnostic{{MissingOperand, 0, "OR"}}},
+		{"missing operand in parentheses", "MIT AND ()", "MIT",
+			[]diagnostic{{MissingOperand, 9, ")"}}},
+		{"missing operator", "MIT Apache-2.0 OR ISC", "MIT AND (Apache-2.0 OR ISC)",
+			[]diagnostic{{MissingOperator, 4, "Apache-2.0"}}},
+		{"unmatched close parenthesis", "MIT) OR ISC)", "MIT OR ISC",
+			[]diagnostic{
+				{UnbalancedParen, 3, ")"},
+				{UnbalancedParen, 11, ")"},
+			}},
+		{"missing exception", "GPL-2.0 WITH MIT OR ISC", "GPL-2.0 OR ISC",
+			[]diagnostic{{MissingException, 13, "MIT"}}},
+		{"unknown exception", "GPL-2.0 WITH Foo-exception OR ISC", "GPL-2.0 OR ISC",
+			[]diagnostic{{UnknownLicense, 13, "Foo-exception"}}},
+		{"malformed reference", "DocumentRef-spdx-tool-1.2 OR MIT AND BAR", "MIT",
+			[]diagnostic{
+				{MalformedReference, 26, "OR"},
+				{UnknownLicense, 37, "BAR"},
+			}},
+		{"document ref at end", "MIT AND DocumentRef-x", "MIT",
+			[]diagnostic{{MalformedReference, 21, ""}}},
+		{"addition ref without WITH", "AdditionRef-Custom OR MIT", "MIT",
+			[]diagnostic{{MalformedReference, 0, "Custom"}}},
+		{"license ref with addition ref", "LicenseRef-X WITH AdditionRef-Custom OR MIT", "LicenseRef-X WITH AdditionRef-Custom OR MIT", nil},
+		{"special value", "NOASSERTION", "NOASSERTION", nil},
+		{"special value in expression", "MIT OR NONE AND ISC", "MIT OR ISC",
+			[]diagnostic{{MisplacedSpecialValue, 7, "NONE"}}},
+		{"space before plus", "Apache-1.0 + OR MIT", "Apache-1.0 OR MIT",
+			[]diagnostic{{UnexpectedSpaceBeforePlus, 11, "+"}}},
+		{"nothing valid", "FOO OR BAR", "",
+			[]diagnostic{
+				{UnknownLicense, 0, "FOO"},
+				{UnknownLicense, 7, "BAR"},
+			}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			expression, err := ParseWithOptions(test.expression, ParseOptions{Recover: true})
+			if test.partial == "" {
+				assert.Nil(t, expression)
+			} else {
+				require.NotNil(t, expression)
+				assert.Equal(t, test.partial, expression.String())
+			}
+
+			if len(test.diagnostics) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			var parseErrs ParseErrors
+			require.True(t, errors.As(err, &parseErrs))
+			diagnostics := make([]diagnostic, len(parseErrs))
+			for i, parseErr := range parseErrs {
+				diagnostics[i] = diagnostic{parseErr.Kind, parseErr.Offset, parseErr.Token}
+			}
+			assert.Equal(t, test.diagnostics, diagnostics)
+
+			// errors.As finds the first problem
+			var parseErr *ParseError
+			require.True(t, errors.As(err, &parseErr))
+			assert.Equal(t, test.diagnostics[0].kind, parseErr.Kind)
+		})
+	}
+}
+
+func TestParseWithOptionsNoRecover(t *testing.T) {
+	expression, err := ParseWithOptions("MIT ANDD (Apache-2.0 OR FOO", ParseOptions{})
+	assert.Nil(t, expression)
+	assert.EqualError(t, err, "unknown license 'ANDD' at offset 4")
+}
+
+func TestParseErrorsError(t *testing.T) {
+	_, err := ParseWithOptions("FOO OR BAR", ParseOptions{Recover: true})
+	assert.EqualError(t, err, "unknown license 'FOO' at offset 0; unknown license 'BAR' at offset 7")
+}
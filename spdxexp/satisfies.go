@@ -4,6 +4,8 @@ import (
 	"errors"
 	"sort"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // ValidateLicenses checks if given licenses are valid according to spdx.
@@ -22,11 +24,22 @@ type ValidateLicensesOptions struct {
 	// FailDeprecatedLicenses rejects deprecated SPDX license identifiers (e.g. "eCos-2.0").
 	FailDeprecatedLicenses bool
 
+	// FailDeprecatedExceptions rejects deprecated SPDX exception identifiers after WITH
+	// (e.g. "LGPL-2.1-only WITH Nokia-Qt-exception-1.1").
+	FailDeprecatedExceptions bool
+
 	// FailAllLicenseRefs rejects all SPDX license references (e.g. "LicenseRef-MyLicense").
 	FailAllLicenseRefs bool
 
 	// FailAllDocumentRefs rejects all SPDX document references (e.g. "DocumentRef-MyDocument").
 	FailAllDocumentRefs bool
+
+	// SpecialValues controls how NONE and NOASSERTION are validated.  By default, they are invalid.
+	SpecialValues SpecialValueHandling
+
+	// LicenseList is the license list that licenses are validated against.  When nil, the list
+	// embedded in the spdxlicenses package is used.
+	LicenseList *LicenseList
 }
 
 // ValidateLicensesWithOptions checks if given licenses are valid according to SPDX.
@@ -41,10 +54,23 @@ func ValidateLicensesWithOptions(licenses []string, options ValidateLicensesOpti
 // ValidateAndNormalizeLicensesWithOptions checks if given licenses are valid according to SPDX.
 // Supports validation options as defined in ValidateLicensesOptions.
 // Returns all validated licenses in their normalized form as the first return value.
-// Returns any invalid licenses as the second return value.
+// Returns any invalid licenses as the second return value.  Special values that need review are
+// returned as invalid; use ValidateAndClassifyLicensesWithOptions to return them separately.
 func ValidateAndNormalizeLicensesWithOptions(licenses []string, options ValidateLicensesOptions) (normalizedLicenses, invalidLicenses []string) {
+	normalizedLicenses, invalidLicenses, reviewLicenses := ValidateAndClassifyLicensesWithOptions(licenses, options)
+	return normalizedLicenses, append(invalidLicenses, reviewLicenses...)
+}
+
+// ValidateAndClassifyLicensesWithOptions checks if given licenses are valid according to SPDX.
+// Supports validation options as defined in ValidateLicensesOptions.
+// Returns all validated licenses in their normalized form as the first return value.
+// Returns any invalid licenses as the second return value.
+// Returns the special values NONE and NOASSERTION as the third return value when
+// options.SpecialValues is ReviewSpecialValues.
+func ValidateAndClassifyLicensesWithOptions(licenses []string, options ValidateLicensesOptions) (normalizedLicenses, invalidLicenses, reviewLicenses []string) {
 	normalizedLicenses = []string{}
 	invalidLicenses = []string{}
+	reviewLicenses = []string{}
 	seenNormalized := make(map[string]struct{}, len(licenses))
 
 	addNormalized := func(license string) {
@@ -61,7 +87,7 @@ func ValidateAndNormalizeLicensesWithOptions(licenses []string, options Validate
 		// Having it before trimming means that licenses with leading/trailing whitespace will not be validated
 		// as MIT by isMIT, but will still be correctly identified using activeLicense.  As this is uncommon, it
 		// is an acceptable tradeoff to avoid the overhead of trimming for the more common case.
-		if isMIT(license) {
+		if options.LicenseList == nil && isMIT(license) {
 			addNormalized("MIT")
 			continue
 		}
@@ -70,12 +96,12 @@ func ValidateAndNormalizeLicensesWithOptions(licenses []string, options Validate
 
 		isAtomic := isAtomicLicense(license)
 		if isAtomic {
-			if ok, normalizedLicense := activeLicense(license); ok {
+			if ok, normalizedLicense := options.LicenseList.activeLicense(license); ok {
 				addNormalized(normalizedLicense)
 				continue
 			}
 
-			if ok, normalizedLicense := deprecatedLicense(license); ok {
+			if ok, normalizedLicense := options.LicenseList.deprecatedLicense(license); ok {
 				if options.FailDeprecatedLicenses {
 					invalidLicenses = append(invalidLicenses, license)
 					continue
@@ -99,32 +125,49 @@ func ValidateAndNormalizeLicensesWithOptions(licenses []string, options Validate
 				}
 			}
 
+			if value, ok := specialValue(license); ok {
+				switch options.SpecialValues {
+				case AllowSpecialValues:
+					addNormalized(value)
+				case ReviewSpecialValues:
+					reviewLicenses = append(reviewLicenses, license)
+				default:
+					invalidLicenses = append(invalidLicenses, license)
+				}
+				continue
+			}
+
 			// need to let this pass through to allow parsing LicenseRef and DocumentRef if either are allowed types
 		}
 
+		isWithException := false
 		if !isAtomic {
 			if hasException, licensePart, exceptionPart := isLicenseWithException(license); hasException {
 				// matches pattern "licensePart WITH exceptionPart", so validate both parts separately
-				if ok, normalizedException := exceptionLicense(exceptionPart); ok {
-					if ok, normalizedLicense := activeLicense(licensePart); ok {
+				if ok, normalizedException := options.exceptionLicense(exceptionPart); ok {
+					if ok, normalizedLicense := options.LicenseList.activeLicense(licensePart); ok {
 						addNormalized(normalizedLicense + " WITH " + normalizedException)
 						continue
 					}
 					if !options.FailDeprecatedLicenses {
-						if ok, normalizedLicense := deprecatedLicense(licensePart); ok {
+						if ok, normalizedLicense := options.LicenseList.deprecatedLicense(licensePart); ok {
 							addNormalized(normalizedLicense + " WITH " + normalizedException)
 							continue
 						}
 					}
 				}
-				invalidLicenses = append(invalidLicenses, license)
-				continue
+				if !isCustomWithException(licensePart, exceptionPart) {
+					invalidLicenses = append(invalidLicenses, license)
+					continue
+				}
+				// need to let a LicenseRef or AdditionRef pass through to be validated by parsing
+				isWithException = true
 			}
 		}
 
 		// all other non-atomic expressions are complex expressions with conjunctions (e.g. "MIT AND Apache-2.0"),
 		// so fail if complex expressions are not allowed
-		if options.FailComplexExpressions && !isAtomic {
+		if options.FailComplexExpressions && !isAtomic && !isWithException {
 			invalidLicenses = append(invalidLicenses, license)
 			continue
 		}
@@ -133,20 +176,45 @@ func ValidateAndNormalizeLicensesWithOptions(licenses []string, options Validate
 		// whether the license expression is valid
 		var parsedLicense *node
 		var err error
-		if parsedLicense, err = parse(license); err != nil {
+		if parsedLicense, err = parseWithLicenses(license, options.LicenseList); err != nil {
+			invalidLicenses = append(invalidLicenses, license)
+		} else if isWithException && !options.allowsReferences(parsedLicense) {
 			invalidLicenses = append(invalidLicenses, license)
 		} else {
 			normalizedLicense := *parsedLicense.reconstructedLicenseString()
 			addNormalized(normalizedLicense)
 		}
 	}
-	return normalizedLicenses, invalidLicenses
+	return normalizedLicenses, invalidLicenses, reviewLicenses
+}
+
+// SatisfiesOptions controls how SatisfiesWithOptions evaluates the test expression.
+type SatisfiesOptions struct {
+	// SpecialValues controls how a test expression of NONE or NOASSERTION is handled.  When they
+	// are allowed, the special value satisfies the allowed list if the list contains it.
+	// Otherwise, an error wrapping ErrSpecialValueRejected or ErrSpecialValueNeedsReview is
+	// returned.
+	SpecialValues SpecialValueHandling
+
+	// LicenseList is the license list that licenses in the test expression and the allowed list
+	// are looked up in.  When nil, the list embedded in the spdxlicenses package is used.  Only
+	// the ids come from it; license ranges (e.g. "Apache-2.0" is allowed by "Apache-1.0+") are
+	// always from the embedded list, so a license that is only in this list has no later versions.
+	LicenseList *LicenseList
 }
 
 // Satisfies determines if the allowed list of licenses satisfies the test license expression.
 // Returns true if allowed list satisfies test license expression; otherwise, false.
 // Returns error if error occurs during processing.
 func Satisfies(testExpression string, allowedList []string) (bool, error) {
+	return SatisfiesWithOptions(testExpression, allowedList, SatisfiesOptions{})
+}
+
+// SatisfiesWithOptions determines if the allowed list of licenses satisfies the test license
+// expression.  Supports options as defined in SatisfiesOptions.
+// Returns true if allowed list satisfies test license expression; otherwise, false.
+// Returns error if error occurs during processing.
+func SatisfiesWithOptions(testExpression string, allowedList []string, options SatisfiesOptions) (bool, error) {
 	if len(allowedList) == 0 {
 		return false, errors.New("allowedList requires at least one element, but is empty")
 	}
@@ -156,20 +224,38 @@ func Satisfies(testExpression string, allowedList []string) (bool, error) {
 	// Having it before trimming means that licenses with leading/trailing whitespace will not be validated
 	// as MIT by isMIT, but will still be correctly identified using activeLicense.  As this is uncommon, it
 	// is an acceptable tradeoff to avoid the overhead of trimming for the more common case.
-	if isMIT(testExpression) {
+	if options.LicenseList == nil && isMIT(testExpression) {
+		needsParsing := false
 		for _, allowed := range allowedList {
 			if strings.EqualFold(allowed, "MIT") {
 				return true, nil
 			}
+			needsParsing = needsParsing || !isAtomicLicense(strings.TrimSpace(allowed)) || isPattern(allowed)
+		}
+		if !needsParsing {
+			return false, nil
 		}
-		return false, nil
+		// MIT can be allowed by an expression (e.g. "MIT OR ISC") or a pattern (e.g. "MI*") in the
+		// allowed list, which requires parsing
 	}
 
 	testExpression = strings.TrimSpace(testExpression)
 
 	if isAtomicLicense(testExpression) {
+		if value, ok := specialValue(testExpression); ok {
+			if err := options.SpecialValues.check(value); err != nil {
+				return false, err
+			}
+			for _, allowed := range allowedList {
+				if strings.EqualFold(allowed, value) {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+
 		// if only one license in the test expression, check for active license to avoid the overhead of parsing
-		if ok, _ := activeLicense(testExpression); ok {
+		if ok, _ := options.LicenseList.activeLicense(testExpression); ok {
 			for _, allowed := range allowedList {
 				if strings.EqualFold(allowed, testExpression) {
 					return true, nil
@@ -178,7 +264,7 @@ func Satisfies(testExpression string, allowedList []string) (bool, error) {
 		}
 
 		// if only one license in the test expression, check for deprecated license to avoid the overhead of parsing
-		if ok, _ := deprecatedLicense(testExpression); ok {
+		if ok, _ := options.LicenseList.deprecatedLicense(testExpression); ok {
 			for _, allowed := range allowedList {
 				if strings.EqualFold(allowed, testExpression) {
 					return true, nil
@@ -190,8 +276,8 @@ func Satisfies(testExpression string, allowedList []string) (bool, error) {
 	// if test expression is a single license with exception, check it now to avoid the overhead of parsing
 	if hasException, licensePart, exceptionPart := isLicenseWithException(testExpression); hasException {
 		// matches pattern "licensePart WITH exceptionPart", so validate both parts separately
-		if ok, _ := activeLicense(licensePart); ok {
-			if ok, _ := exceptionLicense(exceptionPart); ok {
+		if ok, _ := options.LicenseList.activeLicense(licensePart); ok {
+			if ok, _ := options.LicenseList.exceptionLicense(exceptionPart); ok {
 				for _, allowed := range allowedList {
 					if strings.EqualFold(allowed, testExpression) {
 						return true, nil
@@ -202,43 +288,71 @@ func Satisfies(testExpression string, allowedList []string) (bool, error) {
 	}
 
 	// handle all other cases with parsing, which will cover both single and multiple licenses and expressions
-	expressionNode, err := parse(testExpression)
+	expressionNode, err := parseWithLicenses(testExpression, options.LicenseList)
 	if err != nil {
 		return false, err
 	}
-	allowedNodes, err := stringsToNodes(allowedList)
+	allowed, err := compileAllowList(allowedList, options.LicenseList)
 	if err != nil {
 		return false, err
 	}
-	sortAndDedup(allowedNodes)
+	return allowed.satisfies(expressionNode), nil
+}
+
+// satisfiesEvaluator determines if an allowed list satisfies an expression by walking the
+// expression tree, instead of expanding it into ANDed parts that are ORed.  Expanding is
+// exponential in the number of ANDed OR expressions (e.g. "(A OR B) AND (C OR D) AND ..."), while
+// walking the tree evaluates each node at most once.
+type satisfiesEvaluator struct {
+	allowed *AllowList
+	// compatible memoizes whether each distinct license in the expression is compatible with the
+	// allowed list, since checking license ranges is expensive.
+	compatible map[leafKey]bool
+}
 
-	expandedExpression := expressionNode.expand(true)
+// leafKey identifies a license, license ref, or special value node by its values, so that nodes
+// parsed from different parts of an expression share a key.
+type leafKey struct {
+	role    nodeRole
+	lic     licenseNodePartial
+	ref     referenceNodePartial
+	special string
+}
+
+func newSatisfiesEvaluator(allowed *AllowList) *satisfiesEvaluator {
+	return &satisfiesEvaluator{allowed: allowed, compatible: map[leafKey]bool{}}
+}
 
-	for _, expressionPart := range expandedExpression {
-		if isCompatible(expressionPart, allowedNodes) {
-			// return once any expressionPart is compatible with the allow list
-			// * each part is an array of licenses that are ANDed, meaning all have to be on the allowedList
-			// * the parts are ORed, meaning only one of the parts need to be compatible
-			return true, nil
+// satisfies returns true if the allowed list satisfies the node.  An OR expression is satisfied
+// when either side is satisfied, and an AND expression when both sides are, so the right side is
+// only evaluated when the left side does not decide the result.  This matches checking each part
+// of the expanded expression with isCompatible, where any part that is compatible satisfies it.
+func (e *satisfiesEvaluator) satisfies(n *node) bool {
+	if n.isExpression() {
+		if n.isOrExpression() {
+			return e.satisfies(n.left()) || e.satisfies(n.right())
 		}
+		return e.satisfies(n.left()) && e.satisfies(n.right())
+	}
+
+	key := newLeafKey(n)
+	if compatible, ok := e.compatible[key]; ok {
+		return compatible
 	}
-	return false, nil
+	compatible := e.allowed.isAllowed(n)
+	e.compatible[key] = compatible
+	return compatible
 }
 
-// stringsToNodes converts an array of single license strings to to an array of license nodes.
-func stringsToNodes(licenseStrings []string) ([]*node, error) {
-	nodes := make([]*node, len(licenseStrings))
-	for i, s := range licenseStrings {
-		node, err := parse(s)
-		if err != nil {
-			return nil, err
-		}
-		if node.isExpression() {
-			return nil, errors.New("expressions are not supported in the allowedList")
-		}
-		nodes[i] = node
+func newLeafKey(n *node) leafKey {
+	key := leafKey{role: n.role, special: n.special}
+	if n.lic != nil {
+		key.lic = *n.lic
+	}
+	if n.ref != nil {
+		key.ref = *n.ref
 	}
-	return nodes, nil
+	return key
 }
 
 // isMIT checks if the test expression is MIT, ignoring case.
@@ -252,7 +366,22 @@ func isMIT(testExpression string) bool {
 // NOTE: Caller should trim the test expression before calling this function to avoid false
 // negatives (e.g. " MIT " would not be considered a single license).
 func isAtomicLicense(testExpression string) bool {
-	return !strings.Contains(testExpression, " ")
+	return !containsWhitespace(testExpression)
+}
+
+// containsWhitespace checks if the string contains any whitespace, including tabs, line breaks,
+// and other Unicode spaces (e.g. non-breaking space).
+func containsWhitespace(s string) bool {
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f':
+			return true
+		case c >= utf8.RuneSelf:
+			// only check for Unicode spaces when there is a non-ASCII character
+			return strings.ContainsFunc(s[i:], unicode.IsSpace)
+		}
+	}
+	return false
 }
 
 // isException checks if the test expression contains two licenses separated by WITH
@@ -260,14 +389,45 @@ func isAtomicLicense(testExpression string) bool {
 // NOTE: Caller should trim the test expression before calling this function to avoid false
 // negatives (e.g. " MIT " would not be considered a single license).
 func isLicenseWithException(testExpression string) (bool, string, string) {
-	// split by " " and check if there are exactly 3 parts and the middle part is "WITH"
+	// split by whitespace and check if there are exactly 3 parts and the middle part is "WITH" or "with"
 	parts := strings.Fields(testExpression)
-	if len(parts) == 3 && strings.EqualFold(parts[1], "WITH") {
+	if len(parts) == 3 && (parts[1] == "WITH" || parts[1] == "with") {
 		return true, parts[0], parts[2]
 	}
 	return false, "", ""
 }
 
+// isCustomWithException checks if either part of a license with exception is a reference to a
+// custom license or addition (e.g. "LicenseRef-X WITH Bison-exception-2.2" or
+// "GPL-2.0-only WITH AdditionRef-X"), which can only be validated by parsing.
+func isCustomWithException(licensePart, exceptionPart string) bool {
+	return strings.HasPrefix(licensePart, "LicenseRef-") || strings.HasPrefix(licensePart, "DocumentRef-") ||
+		strings.HasPrefix(exceptionPart, "AdditionRef-") || strings.HasPrefix(exceptionPart, "DocumentRef-")
+}
+
+// exceptionLicense returns true if the id is an exception license, including deprecated exception
+// licenses unless FailDeprecatedExceptions is true.
+func (options ValidateLicensesOptions) exceptionLicense(id string) (bool, string) {
+	if ok, normalizedException := options.LicenseList.exceptionLicense(id); ok || options.FailDeprecatedExceptions {
+		return ok, normalizedException
+	}
+	return options.LicenseList.deprecatedException(id)
+}
+
+// allowsReferences checks if the references in a license with exception are allowed by the
+// FailAllLicenseRefs and FailAllDocumentRefs options.
+func (options ValidateLicensesOptions) allowsReferences(n *node) bool {
+	if options.FailAllLicenseRefs && n.isLicenseRef() {
+		return false
+	}
+	if options.FailAllDocumentRefs {
+		if n.hasDocumentRef() || strings.HasPrefix(*n.exception(), "DocumentRef-") {
+			return false
+		}
+	}
+	return true
+}
+
 // isCompatible checks if expressionPart is compatible with allowed list.
 // Expression part is an array of licenses that are ANDed together.
 // Allowed is an array of licenses that can fulfill the expression.
@@ -315,7 +475,7 @@ func isCompatible(expressionPart, allowed []*node) bool {
 //	AND(OR)AND Expression: "MIT AND (ISC OR Apache-2.0) AND GPL-2.0" becomes
 //	    [["GPL-2.0", "ISC", "MIT"], ["Apache-2.0", "GPL-2.0", "MIT"]]
 func (n *node) expand(withDeepSort bool) [][]*node {
-	if n.isLicense() || n.isLicenseRef() {
+	if n.isLicense() || n.isLicenseRef() || n.isSpecialValue() {
 		return [][]*node{{n}}
 	}
 
@@ -346,15 +506,15 @@ func (n *node) expandOr() [][]*node {
 
 // expandOrTerm expands the terms of an OR expression.
 func expandOrTerm(term *node, result [][]*node) [][]*node {
-	if term.isLicense() {
+	if term.isLicense() || term.isLicenseRef() {
 		result = append(result, []*node{term})
 	} else if term.isExpression() {
 		if term.isOrExpression() {
 			left := term.expandOr()
 			result = append(result, left...)
 		} else if term.isAndExpression() {
-			left := term.expandAnd()[0]
-			result = append(result, left)
+			left := term.expandAnd()
+			result = append(result, left...)
 		}
 	}
 	return result
@@ -412,8 +572,9 @@ func appendTerms(left, right [][]*node) [][]*node {
 	var result [][]*node
 	for _, r := range right {
 		for _, l := range left {
-			tmp := l
-			tmp = append(tmp, r...)
+			// copy l, since appending to it could overwrite a part that shares its array
+			tmp := make([]*node, 0, len(l)+len(r))
+			tmp = append(append(tmp, l...), r...)
 			result = append(result, tmp)
 		}
 	}
@@ -429,7 +590,10 @@ func appendTerms(left, right [][]*node) [][]*node {
 //	left: {{"MIT"}} right: {{"ISC", "Apache-2.0"}} becomes
 //	  {{"MIT", "ISC", "Apache-2.0"}}
 func mergeTerms(left, right [][]*node) [][]*node {
-	results := left
+	results := make([][]*node, len(left))
+	for j, l := range left {
+		results[j] = append([]*node{}, l...)
+	}
 	for _, r := range right {
 		for j, l := range results {
 			results[j] = append(l, r...)
@@ -3,9 +3,11 @@ package spdxexp
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidateLicenses(t *testing.T) {
@@ -30,6 +32,19 @@ func TestValidateLicenses(t *testing.T) {
 			"GPL-2.0-or-later WITH Bison-exception-2.2",
 		}, false, []string{"MIT AND APCHE-2.0"}},
 		{"Empty string is invalid", []string{""}, false, []string{""}},
+		{"AdditionRef and WITH after LicenseRef are valid", []string{
+			"GPL-2.0-or-later WITH AdditionRef-Custom",
+			"Apache-2.0 WITH DocumentRef-spdx-tool-1.2:AdditionRef-Custom",
+			"LicenseRef-X WITH AdditionRef-Custom",
+			"LicenseRef-X WITH Bison-exception-2.2",
+			"MIT OR LicenseRef-X WITH AdditionRef-Custom",
+		}, true, []string{}},
+		{"AdditionRef is only valid after WITH", []string{
+			"AdditionRef-Custom",
+			"MIT AND AdditionRef-Custom",
+			"MIT WITH MIT",
+			"BOGUS WITH AdditionRef-Custom",
+		}, false, []string{"AdditionRef-Custom", "MIT AND AdditionRef-Custom", "MIT WITH MIT", "BOGUS WITH AdditionRef-Custom"}},
 	}
 
 	for _, test := range tests {
@@ -141,6 +156,57 @@ func TestValidateLicensesWithOptions_FailDeprecatedLicenses(t *testing.T) {
 	}
 }
 
+func TestValidateLicensesWithOptions_FailDeprecatedExceptions(t *testing.T) {
+	// Nokia-Qt-exception-1.1 is a known deprecated SPDX exception ID (see TestDeprecatedException).
+	deprecatedException := "LGPL-2.1-only WITH Nokia-Qt-exception-1.1"
+
+	tests := []struct {
+		name               string
+		inputLicenses      []string
+		options            ValidateLicensesOptions
+		invalidLicenses    []string
+		normalizedLicenses []string
+	}{
+		{
+			name:               "Deprecated exception allowed by default",
+			inputLicenses:      []string{"lgpl-2.1-only with nokia-qt-exception-1.1"},
+			options:            ValidateLicensesOptions{},
+			invalidLicenses:    []string{},
+			normalizedLicenses: []string{deprecatedException},
+		},
+		{
+			name:               "Deprecated exception rejected",
+			inputLicenses:      []string{deprecatedException},
+			options:            ValidateLicensesOptions{FailDeprecatedExceptions: true},
+			invalidLicenses:    []string{deprecatedException},
+			normalizedLicenses: []string{},
+		},
+		{
+			name:               "Mixed list rejects only deprecated exceptions",
+			inputLicenses:      []string{"GPL-2.0-only WITH Classpath-exception-2.0", deprecatedException},
+			options:            ValidateLicensesOptions{FailDeprecatedExceptions: true},
+			invalidLicenses:    []string{deprecatedException},
+			normalizedLicenses: []string{"GPL-2.0-only WITH Classpath-exception-2.0"},
+		},
+		{
+			name:               "FailDeprecatedLicenses allows deprecated exceptions",
+			inputLicenses:      []string{deprecatedException},
+			options:            ValidateLicensesOptions{FailDeprecatedLicenses: true},
+			invalidLicenses:    []string{},
+			normalizedLicenses: []string{deprecatedException},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			normalizedLicenses, invalidLicenses := ValidateAndNormalizeLicensesWithOptions(test.inputLicenses, test.options)
+			assert.EqualValues(t, test.invalidLicenses, invalidLicenses)
+			assert.EqualValues(t, test.normalizedLicenses, normalizedLicenses)
+		})
+	}
+}
+
 func TestValidateLicensesWithOptions_AllOptions(t *testing.T) {
 	documentRef := "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2"
 	licenseRef := "LicenseRef-MIT-Style-1"
@@ -254,6 +320,22 @@ func TestValidateAndNormalizeLicensesWithOptions_FailComplexExpressions(t *testi
 			},
 			normalizedLicenses: []string{"MIT", "Apache-2.0"},
 		},
+		{
+			name:          "Multi-line and lowercase expressions rejected",
+			inputLicenses: []string{"MIT\nAND\nISC", "mit or isc", "MIT\u00a0OR\u00a0ISC"},
+			options:       ValidateLicensesOptions{FailComplexExpressions: true},
+			invalidLicenses: []string{
+				"MIT\nAND\nISC", "mit or isc", "MIT\u00a0OR\u00a0ISC",
+			},
+			normalizedLicenses: []string{},
+		},
+		{
+			name:               "Multi-line and lowercase expressions normalized",
+			inputLicenses:      []string{"MIT\nAND\nISC", "mit or isc", "MIT\u00a0OR\u00a0ISC", "gpl-2.0-or-later\twith\tbison-exception-2.2"},
+			options:            ValidateLicensesOptions{},
+			invalidLicenses:    []string{},
+			normalizedLicenses: []string{"MIT AND ISC", "MIT OR ISC", "GPL-2.0-or-later WITH Bison-exception-2.2"},
+		},
 		{
 			name:               "WITH exception is not treated as complex expression",
 			inputLicenses:      []string{"gpl-2.0-or-later WITH Bison-exception-2.2"},
@@ -272,6 +354,64 @@ func TestValidateAndNormalizeLicensesWithOptions_FailComplexExpressions(t *testi
 	}
 }
 
+func TestValidateAndClassifyLicensesWithOptions_SpecialValues(t *testing.T) {
+	tests := []struct {
+		name               string
+		inputLicenses      []string
+		options            ValidateLicensesOptions
+		normalizedLicenses []string
+		invalidLicenses    []string
+		reviewLicenses     []string
+	}{
+		{
+			name:               "Special values rejected by default",
+			inputLicenses:      []string{"MIT", "NONE", "NOASSERTION"},
+			options:            ValidateLicensesOptions{},
+			normalizedLicenses: []string{"MIT"},
+			invalidLicenses:    []string{"NONE", "NOASSERTION"},
+			reviewLicenses:     []string{},
+		},
+		{
+			name:               "Special values need review",
+			inputLicenses:      []string{"MIT", "NONE", " noassertion "},
+			options:            ValidateLicensesOptions{SpecialValues: ReviewSpecialValues},
+			normalizedLicenses: []string{"MIT"},
+			invalidLicenses:    []string{},
+			reviewLicenses:     []string{"NONE", "noassertion"},
+		},
+		{
+			name:               "Special values allowed",
+			inputLicenses:      []string{"MIT", "none", "NOASSERTION", "NoAssertion"},
+			options:            ValidateLicensesOptions{SpecialValues: AllowSpecialValues},
+			normalizedLicenses: []string{"MIT", "NONE", "NOASSERTION"},
+			invalidLicenses:    []string{},
+			reviewLicenses:     []string{},
+		},
+		{
+			name:               "Special values are invalid in expressions even when allowed",
+			inputLicenses:      []string{"MIT OR NONE", "NOASSERTION AND MIT", "NONE WITH Bison-exception-2.2"},
+			options:            ValidateLicensesOptions{SpecialValues: AllowSpecialValues},
+			normalizedLicenses: []string{},
+			invalidLicenses:    []string{"MIT OR NONE", "NOASSERTION AND MIT", "NONE WITH Bison-exception-2.2"},
+			reviewLicenses:     []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			normalizedLicenses, invalidLicenses, reviewLicenses := ValidateAndClassifyLicensesWithOptions(test.inputLicenses, test.options)
+			assert.EqualValues(t, test.normalizedLicenses, normalizedLicenses, "normalized licenses should match expected")
+			assert.EqualValues(t, test.invalidLicenses, invalidLicenses, "invalid licenses should match expected")
+			assert.EqualValues(t, test.reviewLicenses, reviewLicenses, "review licenses should match expected")
+
+			// licenses that need review are invalid when they cannot be returned separately
+			valid, invalidLicenses := ValidateLicensesWithOptions(test.inputLicenses, test.options)
+			assert.EqualValues(t, append(test.invalidLicenses, test.reviewLicenses...), invalidLicenses)
+			assert.Equal(t, len(test.invalidLicenses)+len(test.reviewLicenses) == 0, valid)
+		})
+	}
+}
+
 func TestValidateAndNormalizeLicensesWithOptions_FailDeprecatedLicenses(t *testing.T) {
 	// eCos-2.0 is a known deprecated SPDX license ID (see TestDeprecatedLicense).
 	deprecatedLicense := "eCos-2.0"
@@ -422,6 +562,38 @@ func TestValidateAndNormalizeLicensesWithOptions_AllOptions(t *testing.T) {
 	}
 }
 
+func TestSatisfiesWithOptions_SpecialValues(t *testing.T) {
+	tests := []struct {
+		name           string
+		testExpression string
+		allowedList    []string
+		options        SatisfiesOptions
+		satisfied      bool
+		err            error
+	}{
+		{"special value rejected by default", "NOASSERTION", []string{"MIT", "NOASSERTION"}, SatisfiesOptions{}, false, ErrSpecialValueRejected},
+		{"special value needs review", "NONE", []string{"MIT", "NONE"}, SatisfiesOptions{SpecialValues: ReviewSpecialValues}, false, ErrSpecialValueNeedsReview},
+		{"allowed special value in allowed list", " none ", []string{"MIT", "NONE"}, SatisfiesOptions{SpecialValues: AllowSpecialValues}, true, nil},
+		{"allowed special value not in allowed list", "NOASSERTION", []string{"MIT", "NONE"}, SatisfiesOptions{SpecialValues: AllowSpecialValues}, false, nil},
+		{"special value in allowed list does not match license", "ISC", []string{"MIT", "NONE"}, SatisfiesOptions{SpecialValues: AllowSpecialValues}, false, nil},
+		{"licenses are satisfied with special value in allowed list", "MIT AND ISC", []string{"MIT", "ISC", "NOASSERTION"}, SatisfiesOptions{}, true, nil},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			satisfied, err := SatisfiesWithOptions(test.testExpression, test.allowedList, test.options)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.satisfied, satisfied)
+		})
+	}
+
+	_, err := SatisfiesWithOptions("MIT OR NOASSERTION", []string{"MIT"}, SatisfiesOptions{SpecialValues: AllowSpecialValues})
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, MisplacedSpecialValue, parseErr.Kind)
+}
+
 // TestSatisfiesSingle lets you quickly test a single call to Satisfies with a specific license expression and allowed list of licenses.
 // To test a different expression, change the expression, allowed licenses, and expected result in the function body.
 // TO RUN: go test ./expression -run TestSatisfiesSingle
@@ -482,6 +654,25 @@ func TestSatisfies_FastPathValidation(t *testing.T) {
 			allowedList:    []string{"GPL-2.0-or-later WITH Bison-exception-2.2"},
 			satisfied:      true,
 		},
+		{
+			name:           "Single license WITH exception separated by tabs",
+			repoExpression: "GPL-2.0-or-later\tWITH\tBison-exception-2.2",
+			allowedList:    []string{"GPL-2.0-or-later WITH Bison-exception-2.2"},
+			satisfied:      true,
+		},
+		{
+			name:           "Multi-line expression",
+			repoExpression: "MIT\nAND\nISC",
+			allowedList:    []string{"MIT", "ISC"},
+			satisfied:      true,
+		},
+		{
+			name:           "Single license With exception in mixed case returns error",
+			repoExpression: "GPL-2.0-or-later With Bison-exception-2.2",
+			allowedList:    []string{"GPL-2.0-or-later WITH Bison-exception-2.2"},
+			expectErr:      true,
+			expectedErr:    "unknown license 'With' at offset 17",
+		},
 		{
 			name:           "Single license WITH invalid exception returns error",
 			repoExpression: "GPL-2.0-or-later WITH NOT-A-REAL-EXCEPTION",
@@ -533,7 +724,9 @@ func TestSatisfies(t *testing.T) {
 		{"err - invalid license", "NON-EXISTENT-LICENSE", []string{"MIT", "Apache-2.0"}, false,
 			errors.New("unknown license 'NON-EXISTENT-LICENSE' at offset 0")},
 		{"err - invalid license in allowed list", "Apache-1.0", []string{"NON-EXISTENT-LICENSE", "Apache-2.0"}, false,
-			errors.New("unknown license 'NON-EXISTENT-LICENSE' at offset 0")},
+			errors.New("invalid allowedList entry \"NON-EXISTENT-LICENSE\" at index 0: unknown license 'NON-EXISTENT-LICENSE' at offset 0")},
+		{"err - invalid expression in allowed list", "Apache-1.0", []string{"Apache-2.0", "MIT OR"}, false,
+			errors.New("invalid allowedList entry \"MIT OR\" at index 1: expected expression following OR, but found none")},
 
 		{"MIT satisfies [MIT, Apache-2.0]", "MIT", []string{"MIT", "Apache-2.0"}, true, nil},
 		{"MIT OR Apache-2.0 satisfies [MIT]", "MIT OR Apache-2.0", []string{"MIT"}, true, nil},
@@ -641,13 +834,173 @@ func TestSatisfies(t *testing.T) {
 		{"licenseRef alone not allowed, but with documentRef allowed",
 			"MIT AND LicenseRef-X-BSD-3-Clause-Golang",
 			[]string{"MIT", "Apache-2.0", "DocumentRef-spdx-tool-1.2:LicenseRef-X-BSD-3-Clause-Golang"}, false, nil},
+		{"licenseRef ORed with license allowed",
+			"LicenseRef-X OR ISC",
+			[]string{"LicenseRef-X"}, true, nil},
+		{"additionRef allowed",
+			"GPL-2.0-only WITH AdditionRef-Custom",
+			[]string{"MIT", "GPL-2.0-only WITH AdditionRef-Custom"}, true, nil},
+		{"additionRef must match exactly",
+			"GPL-2.0-only WITH AdditionRef-Custom",
+			[]string{"MIT", "GPL-2.0-only WITH AdditionRef-Other"}, false, nil},
+		{"additionRef not allowed without addition",
+			"GPL-2.0-only WITH AdditionRef-Custom",
+			[]string{"MIT", "GPL-2.0-only"}, false, nil},
+		{"additionRef with documentRef allowed",
+			"MIT AND Apache-2.0 WITH DocumentRef-spdx-tool-1.2:AdditionRef-Custom",
+			[]string{"MIT", "Apache-2.0 WITH DocumentRef-spdx-tool-1.2:AdditionRef-Custom"}, true, nil},
+		{"additionRef with documentRef must match documentRef",
+			"Apache-2.0 WITH DocumentRef-spdx-tool-1.2:AdditionRef-Custom",
+			[]string{"Apache-2.0 WITH AdditionRef-Custom"}, false, nil},
+		{"licenseRef with additionRef allowed",
+			"LicenseRef-X WITH AdditionRef-Custom OR ISC",
+			[]string{"LicenseRef-X WITH AdditionRef-Custom"}, true, nil},
+		{"licenseRef with exception not allowed without exception",
+			"LicenseRef-X WITH Bison-exception-2.2",
+			[]string{"LicenseRef-X"}, false, nil},
+		{"licenseRef without exception not allowed by licenseRef with exception",
+			"LicenseRef-X",
+			[]string{"LicenseRef-X WITH Bison-exception-2.2"}, false, nil},
 	}
 
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
 			satisfied, err := Satisfies(test.repoExpression, test.allowedList)
-			assert.Equal(t, test.err, err)
+			requireEqualError(t, test.err, err)
+			assert.Equal(t, test.satisfied, satisfied)
+		})
+	}
+}
+
+func TestSatisfies_CompoundAllowedList(t *testing.T) {
+	tests := []struct {
+		name           string
+		testExpression string
+		allowedList    []string
+		satisfied      bool
+	}{
+		{"MIT allowed by OR", "MIT", []string{"ISC OR MIT"}, true},
+		{"MIT not allowed by OR", "MIT", []string{"ISC OR Apache-2.0"}, false},
+		{"exception allowed by OR", "GPL-2.0-only WITH Classpath-exception-2.0",
+			[]string{"GPL-2.0-only WITH Classpath-exception-2.0 OR CDDL-1.1"}, true},
+		{"other license allowed by OR", "CDDL-1.1", []string{"GPL-2.0-only WITH Classpath-exception-2.0 OR CDDL-1.1"}, true},
+		{"bundle allowed", "MIT AND Apache-2.0", []string{"Apache-2.0 AND MIT"}, true},
+		{"part of bundle not allowed", "MIT", []string{"MIT AND Apache-2.0"}, false},
+		{"incomplete bundle not allowed", "MIT AND Apache-2.0", []string{"MIT AND Apache-2.0 AND ISC"}, false},
+		{"bundle and license allowed", "Apache-2.0 AND MIT AND ISC", []string{"MIT AND Apache-2.0", "ISC"}, true},
+		{"bundle in one branch", "(MIT OR ISC) AND Apache-2.0", []string{"MIT AND Apache-2.0"}, true},
+		{"bundle in no branch", "(MIT AND ISC) OR Apache-2.0", []string{"MIT AND Apache-2.0"}, false},
+		{"bundle with range", "Apache-2.0 AND MIT", []string{"Apache-1.0+ AND MIT"}, true},
+		{"bundle ORed with license", "MIT", []string{"ISC OR (MIT AND Apache-2.0)"}, false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			satisfied, err := Satisfies(test.testExpression, test.allowedList)
+			require.NoError(t, err)
+			assert.Equal(t, test.satisfied, satisfied)
+
+			allowList, err := CompileAllowList(test.allowedList)
+			require.NoError(t, err)
+			satisfied, err = allowList.Satisfies(test.testExpression)
+			require.NoError(t, err)
+			assert.Equal(t, test.satisfied, satisfied)
+		})
+	}
+}
+
+func TestSatisfiesEvaluator(t *testing.T) {
+	allowedList := []string{"MIT", "Apache-2.0+", "GPL-2.0-only WITH Classpath-exception-2.0", "LicenseRef-X"}
+	tests := []struct {
+		name       string
+		expression string
+		satisfied  bool
+	}{
+		{"license", "MIT", true},
+		{"license not allowed", "ISC", false},
+		{"OR", "ISC OR MIT", true},
+		{"OR not allowed", "ISC OR 0BSD", false},
+		{"AND", "MIT AND Apache-2.0", true},
+		{"AND not allowed", "MIT AND ISC", false},
+		{"license in range", "ISC OR Apache-2.0 AND MIT", true},
+		{"exception", "GPL-2.0 WITH Classpath-exception-2.0 AND LicenseRef-X", true},
+		{"exception not allowed", "GPL-2.0 AND LicenseRef-X", false},
+		{"AND of ORs", "(ISC OR MIT) AND (0BSD OR LicenseRef-X) AND (Apache-2.0 OR BSD-2-Clause)", true},
+		{"AND of ORs not allowed", "(ISC OR MIT) AND (0BSD OR LicenseRef-Y) AND (Apache-2.0 OR BSD-2-Clause)", false},
+		{"OR of ANDs", "(ISC AND MIT) OR (0BSD AND LicenseRef-X) OR (Apache-2.0 AND MIT)", true},
+		{"OR of ANDs not allowed", "(ISC AND MIT) OR (0BSD AND LicenseRef-X) OR (Apache-2.0 AND ISC)", false},
+		{"nested", "ISC OR (MIT AND (0BSD OR (Apache-2.0 AND (BSD-2-Clause OR LicenseRef-X))))", true},
+		{"nested not allowed", "ISC OR (MIT AND (0BSD OR (Apache-2.0 AND (BSD-2-Clause OR LicenseRef-Y))))", false},
+		{"AND of ANDs and ORs", "(((Apache-2.0 OR ISC) AND (Apache-2.0 AND (Apache-2.0 OR MIT))) AND LicenseRef-X) AND (Apache-2.0 OR 0BSD)", true},
+	}
+
+	allowed, err := CompileAllowList(allowedList)
+	require.NoError(t, err)
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			expression, err := parse(test.expression)
+			require.NoError(t, err)
+			assert.Equal(t, test.satisfied, newSatisfiesEvaluator(allowed).satisfies(expression))
+
+			// the result is the same as checking each part of the expanded expression
+			expanded := false
+			for _, part := range expression.expand(true) {
+				expanded = expanded || isCompatible(part, allowed.allowed)
+			}
+			assert.Equal(t, expanded, test.satisfied)
+
+			satisfied, err := Satisfies(test.expression, allowedList)
+			require.NoError(t, err)
+			assert.Equal(t, test.satisfied, satisfied)
+		})
+	}
+
+	// expanding this expression would produce 2^64 parts
+	satisfied, err := Satisfies(manyANDedORs(64), []string{"LicenseRef-0b", "LicenseRef-63a"})
+	require.NoError(t, err)
+	assert.False(t, satisfied)
+}
+
+func TestSatisfies_BundleWithManyANDedORs(t *testing.T) {
+	// 20 ANDed ORs of distinct licenses, which has 2^20 branches
+	ors := []string{"(MIT OR Apache-2.0)", "(ISC OR 0BSD)"}
+	for i := 0; len(ors) < 20; i++ {
+		ors = append(ors, fmt.Sprintf("(%s OR %s)", allowList()[2*i+2], allowList()[2*i+3]))
+	}
+	expression := strings.Join(ors, " AND ")
+	// MIT and ISC are only allowed by the bundle
+	bundleExpression := strings.NewReplacer("Apache-2.0", "LicenseRef-X", "0BSD", "LicenseRef-Y").Replace(expression)
+	var withoutMITAndISC []string
+	for _, license := range allowList() {
+		if license != "MIT" && license != "ISC" {
+			withoutMITAndISC = append(withoutMITAndISC, license)
+		}
+	}
+	// clip, so that appending to the list for each test copies it
+	withoutMITAndISC = withoutMITAndISC[:len(withoutMITAndISC):len(withoutMITAndISC)]
+
+	tests := []struct {
+		name        string
+		expression  string
+		allowedList []string
+		satisfied   bool
+	}{
+		{"without bundle", expression, allowList(), true},
+		{"bundle not needed", expression, append(allowList(), "MIT AND ISC"), true},
+		{"bundle needed", bundleExpression, append(withoutMITAndISC, "MIT AND ISC"), true},
+		{"incomplete bundle", bundleExpression, append(withoutMITAndISC, "MIT AND ISC AND LicenseRef-Z"), false},
+		{"bundle with license not allowed", bundleExpression + " AND LicenseRef-Z", append(withoutMITAndISC, "MIT AND ISC"), false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			satisfied, err := Satisfies(test.expression, test.allowedList)
+			require.NoError(t, err)
 			assert.Equal(t, test.satisfied, satisfied)
 		})
 	}
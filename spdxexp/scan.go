@@ -7,17 +7,25 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 type expressionStream struct {
 	expression string
 	index      int
 	err        error
+	recovering bool          // when true, errors are collected in errs and scanning continues
+	errs       []*ParseError // errors collected while recovering
+	pending    *token        // token produced by normalization that follows the last token read
+	licenses   *LicenseList  // license list to look up ids in, or nil for the default list
 }
 
 type token struct {
 	role  tokenrole
 	value string
+	start int // byte offset of the first character of the token in the expression
+	end   int // byte offset following the last character of the token in the expression
 }
 
 type tokenrole uint8
@@ -28,15 +36,31 @@ const (
 	licenseRefToken
 	licenseToken
 	exceptionToken
+	additionRefToken
+	specialValueToken // NONE or NOASSERTION
+	invalidToken      // placeholder for text that could not be scanned; only produced while recovering
 )
 
-// Scan scans a string expression gathering valid SPDX expression tokens.  Returns error if any tokens are invalid.
-func scan(expression string) ([]token, error) {
+// Scan scans a string expression gathering valid SPDX expression tokens.  Ids are looked up in the
+// license list, or the default list if it is nil.  Returns error if any tokens are invalid.
+func scan(expression string, licenses *LicenseList) ([]token, error) {
+	exp := &expressionStream{expression: expression, index: 0, err: nil, licenses: licenses}
+	return exp.scanTokens()
+}
+
+// scanRecovering scans a string expression like scan, but instead of stopping at the first error,
+// it records the error, replaces the offending text with an invalid token, and continues scanning.
+// Returns all tokens and all errors found.
+func scanRecovering(expression string, licenses *LicenseList) ([]token, []*ParseError) {
+	exp := &expressionStream{expression: expression, index: 0, err: nil, recovering: true, licenses: licenses}
+	tokens, _ := exp.scanTokens()
+	return tokens, exp.errs
+}
+
+func (exp *expressionStream) scanTokens() ([]token, error) {
 	var tokens []token
 	var token *token
 
-	exp := &expressionStream{expression: expression, index: 0, err: nil}
-
 	for exp.hasMore() {
 		exp.skipWhitespace()
 		if !exp.hasMore() {
@@ -45,20 +69,55 @@ func scan(expression string) ([]token, error) {
 
 		token = exp.parseToken()
 		if exp.err != nil {
-			// stop processing at first error and return
-			return nil, exp.err
+			if !exp.recovering {
+				// stop processing at first error and return
+				return nil, exp.err
+			}
+			token = exp.skipInvalid()
+			if token == nil {
+				continue
+			}
 		}
 
 		if token == nil {
 			// TODO: shouldn't happen ???
-			return nil, errors.New("got nil token when expecting more")
+			return nil, newParseError(InvalidSyntax, exp.index, "", "got nil token when expecting more")
 		}
 
 		tokens = append(tokens, *token)
+		if exp.pending != nil {
+			tokens = append(tokens, *exp.pending)
+			exp.pending = nil
+		}
 	}
 	return tokens, nil
 }
 
+// Record the current error and skip past the text that caused it.  Returns an invalid token
+// covering the skipped text, or nil if the text can be dropped without affecting the structure
+// of the expression.
+func (exp *expressionStream) skipInvalid() *token {
+	var parseErr *ParseError
+	if !errors.As(exp.err, &parseErr) {
+		parseErr = newParseError(InvalidSyntax, exp.index, "", exp.err.Error())
+	}
+	exp.errs = append(exp.errs, parseErr)
+	exp.err = nil
+
+	if parseErr.Kind == UnexpectedSpaceBeforePlus {
+		// drop the + and continue as if it was not there
+		exp.index++
+		return nil
+	}
+
+	start := exp.index
+	if len(exp.readRegex("[A-Za-z0-9-.]+[+]?")) == 0 {
+		_, size := utf8.DecodeRuneInString(exp.expression[exp.index:])
+		exp.index += size
+	}
+	return &token{role: invalidToken, value: exp.expression[start:exp.index], start: start, end: exp.index}
+}
+
 // Determine if expression has more to process.
 func (exp *expressionStream) hasMore() bool {
 	return exp.index < len(exp.expression)
@@ -91,6 +150,14 @@ func (exp *expressionStream) parseToken() *token {
 		return lref
 	}
 
+	aref := exp.readAdditionRef()
+	if exp.err != nil {
+		return nil
+	}
+	if aref != nil {
+		return aref
+	}
+
 	identifier := exp.readLicense()
 	if exp.err != nil {
 		return nil
@@ -100,7 +167,8 @@ func (exp *expressionStream) parseToken() *token {
 	}
 
 	errmsg := fmt.Sprintf("unexpected '%c' at offset %d", exp.expression[exp.index], exp.index)
-	exp.err = errors.New(errmsg)
+	exp.err = newParseError(UnexpectedCharacter, exp.index, exp.expression[exp.index:exp.index+1], errmsg,
+		expectedLicense, expectedLicenseRef, expectedDocumentRef, "(")
 	return nil
 }
 
@@ -130,14 +198,23 @@ func (exp *expressionStream) read(next string) string {
 	return ""
 }
 
-// Skip whitespace in expression starting at index
+// Skip whitespace in expression starting at index.  Whitespace includes tabs, line breaks, and
+// other Unicode spaces (e.g. non-breaking space), so that expressions can span multiple lines.
 func (exp *expressionStream) skipWhitespace() {
-	exp.readRegex("[ ]*")
+	for exp.hasMore() {
+		r, size := utf8.DecodeRuneInString(exp.expression[exp.index:])
+		if !unicode.IsSpace(r) {
+			return
+		}
+		exp.index += size
+	}
 }
 
-// Read operator in expression starting at index if it exists
+// Read operator in expression starting at index if it exists.  Per SPDX 2.3 Annex D, the word
+// operators can be all uppercase or all lowercase (e.g. "AND" or "and", but not "And").  The
+// value of the token is always uppercase.
 func (exp *expressionStream) readOperator() *token {
-	possibilities := []string{"WITH", "AND", "OR", "(", ")", ":", "+"}
+	possibilities := []string{"WITH", "AND", "OR", "with", "and", "or", "(", ")", ":", "+"}
 
 	var op string
 	for _, p := range possibilities {
@@ -150,14 +227,33 @@ func (exp *expressionStream) readOperator() *token {
 		// not an error if an operator isn't found
 		return nil
 	}
-
-	if op == "+" && exp.index > 1 && exp.expression[exp.index-2:exp.index-1] == " " {
-		exp.err = errors.New("unexpected space before +")
-		exp.index--
+	op = strings.ToUpper(op)
+	if isWordOperator(op) && exp.hasMore() && isIDChar(exp.expression[exp.index]) {
+		// operator is the start of a longer word (e.g. "ANDD", "order"), so it isn't an operator
+		exp.index -= len(op)
 		return nil
 	}
 
-	return &token{role: operatorToken, value: op}
+	start := exp.index - len(op)
+	if op == "+" && start > 0 {
+		if r, _ := utf8.DecodeLastRuneInString(exp.expression[:start]); unicode.IsSpace(r) {
+			exp.index--
+			exp.err = newParseError(UnexpectedSpaceBeforePlus, exp.index, op, "unexpected space before +")
+			return nil
+		}
+	}
+
+	return &token{role: operatorToken, value: op, start: start, end: exp.index}
+}
+
+// Return true if the operator is a word (e.g. AND) rather than punctuation (e.g. +).
+func isWordOperator(op string) bool {
+	return op == "WITH" || op == "AND" || op == "OR"
+}
+
+// Return true if the character can be part of an id.
+func isIDChar(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '.'
 }
 
 // Get id from expression starting at index.  Raise error if id not found.
@@ -165,7 +261,11 @@ func (exp *expressionStream) readID() string {
 	id := exp.readRegex("[A-Za-z0-9-.]+")
 	if len(id) == 0 {
 		errmsg := fmt.Sprintf("expected id at offset %d", exp.index)
-		exp.err = errors.New(errmsg)
+		found := ""
+		if exp.hasMore() {
+			found = exp.expression[exp.index : exp.index+1]
+		}
+		exp.err = newParseError(MissingIdentifier, exp.index, found, errmsg, expectedID)
 		return ""
 	}
 	return id
@@ -173,6 +273,7 @@ func (exp *expressionStream) readID() string {
 
 // Read DocumentRef in expression starting at index if it exists. Raise error if found and id doesn't follow.
 func (exp *expressionStream) readDocumentRef() *token {
+	start := exp.index
 	ref := exp.read("DocumentRef-")
 	if len(ref) == 0 {
 		// not an error if a DocumentRef isn't found
@@ -183,11 +284,12 @@ func (exp *expressionStream) readDocumentRef() *token {
 	if exp.err != nil {
 		return nil
 	}
-	return &token{role: documentRefToken, value: id}
+	return &token{role: documentRefToken, value: id, start: start, end: exp.index}
 }
 
 // Read LicenseRef in expression starting at index if it exists. Raise error if found and id doesn't follow.
 func (exp *expressionStream) readLicenseRef() *token {
+	start := exp.index
 	ref := exp.read("LicenseRef-")
 	if len(ref) == 0 {
 		// not an error if a LicenseRef isn't found
@@ -198,7 +300,23 @@ func (exp *expressionStream) readLicenseRef() *token {
 	if exp.err != nil {
 		return nil
 	}
-	return &token{role: licenseRefToken, value: id}
+	return &token{role: licenseRefToken, value: id, start: start, end: exp.index}
+}
+
+// Read AdditionRef in expression starting at index if it exists. Raise error if found and id doesn't follow.
+func (exp *expressionStream) readAdditionRef() *token {
+	start := exp.index
+	ref := exp.read("AdditionRef-")
+	if len(ref) == 0 {
+		// not an error if an AdditionRef isn't found
+		return nil
+	}
+
+	id := exp.readID()
+	if exp.err != nil {
+		return nil
+	}
+	return &token{role: additionRefToken, value: id, start: start, end: exp.index}
 }
 
 // Read a LICENSE/EXCEPTION in expression starting at index if it exists. Raise error if found and id doesn't follow.
@@ -211,14 +329,26 @@ func (exp *expressionStream) readLicense() *token {
 		return nil
 	}
 
+	if value, ok := specialValue(license); ok {
+		return &token{role: specialValueToken, value: value, start: index, end: exp.index}
+	}
+
 	if token := exp.normalizeLicense(license); token != nil {
+		token.start = index
+		token.end = exp.index
+		if exp.pending != nil {
+			// the license ends where the text normalized to the pending token begins
+			token.end = exp.pending.start
+		}
 		return token
 	}
 
 	// license not found in indices, need to reset index since readID advanced it
 	exp.index = index
 	errmsg := fmt.Sprintf("unknown license '%s' at offset %d", license, exp.index)
-	exp.err = errors.New(errmsg)
+	parseErr := newParseError(UnknownLicense, exp.index, license, errmsg, expectedLicense, expectedException)
+	parseErr.licenses = exp.licenses
+	exp.err = parseErr
 	return nil
 }
 
@@ -228,12 +358,13 @@ func (exp *expressionStream) readLicense() *token {
 //   - a_license-2.0, a_license, a_license-ab - there is variability in the form of the base license.  a_license-2.0 is used for these
 //     examples, but any base license form can have the suffixes described.
 //   - a_license-2.0-only - normalizes to a_license-2.0 if the -only form is not specifically in the set of licenses
-//   - a_license-2.0-or-later - normalizes to a_license-2.0+ if the -or-later form is not specifically in the set of licenses
+//   - a_license-2.0-or-later - normalizes to a_license-2.0+ if the -or-later form is not specifically in the set of licenses.
+//     The + operator token is left in pending with the span of the -or-later suffix.
 //   - a_license-2.0+ - normalizes to a_license-2.0-or-later if the -or-later form is specifically in the set of licenses
 func (exp *expressionStream) normalizeLicense(license string) *token {
-	if token := licenseLookup(license); token != nil {
+	if token := exp.licenseLookup(license); token != nil {
 		// checks active and exception license lists
-		// deprecated list is checked at the end to avoid a deprecated license being used for +
+		// deprecated lists are checked at the end to avoid a deprecated license being used for +
 		// (example: GPL-1.0 is on the deprecated list, but GPL-1.0+ should become GPL-1.0-or-later)
 		return token
 	}
@@ -241,14 +372,14 @@ func (exp *expressionStream) normalizeLicense(license string) *token {
 	lenLicense := len(license)
 	if strings.HasSuffix(license, "-only") {
 		adjustedLicense := license[0 : lenLicense-5]
-		if token := licenseLookup(adjustedLicense); token != nil {
+		if token := exp.licenseLookup(adjustedLicense); token != nil {
 			// no need to remove the -only from the expression stream; it is ignored
 			return token
 		}
 	}
 	if exp.hasMore() && exp.expression[exp.index:exp.index+1] == "+" {
 		adjustedLicense := license[0:lenLicense] + "-or-later"
-		if token := licenseLookup(adjustedLicense); token != nil {
+		if token := exp.licenseLookup(adjustedLicense); token != nil {
 			// need to consume the + to avoid a + operator token being added
 			exp.index++
 			return token
@@ -256,41 +387,39 @@ func (exp *expressionStream) normalizeLicense(license string) *token {
 	}
 	if strings.HasSuffix(license, "-or-later") {
 		adjustedLicense := license[0 : lenLicense-9]
-		if token := licenseLookup(adjustedLicense); token != nil {
-			// replace `-or-later` with `+`
-			newExpression := exp.expression[0:exp.index-len("-or-later")] + "+"
-			if exp.hasMore() {
-				newExpression += exp.expression[exp.index+1:]
-			}
-			exp.expression = newExpression
-			// update index to remove `-or-later`; now pointing at the `+` operator
-			exp.index -= len("-or-later")
-
-			return token
+		if license := exp.licenseLookup(adjustedLicense); license != nil {
+			// treat `-or-later` as a `+` operator; the expression is not modified so that offsets
+			// of later tokens still refer to the original expression
+			exp.pending = &token{role: operatorToken, value: "+", start: exp.index - len("-or-later"), end: exp.index}
+			return license
 		}
 	}
 
-	return deprecatedLicenseLookup(license)
+	return exp.deprecatedLicenseLookup(license)
 }
 
 // Lookup license identifier in active and exception lists to determine if it is a supported SPDX id
-func licenseLookup(license string) *token {
-	active, preferredLicense := activeLicense(license)
+func (exp *expressionStream) licenseLookup(license string) *token {
+	active, preferredLicense := exp.licenses.activeLicense(license)
 	if active {
 		return &token{role: licenseToken, value: preferredLicense}
 	}
-	exception, preferredLicense := exceptionLicense(license)
+	exception, preferredLicense := exp.licenses.exceptionLicense(license)
 	if exception {
 		return &token{role: exceptionToken, value: preferredLicense}
 	}
 	return nil
 }
 
-// Lookup license identifier in deprecated list to determine if it is a supported SPDX id
-func deprecatedLicenseLookup(license string) *token {
-	deprecated, preferredLicense := deprecatedLicense(license)
+// Lookup license identifier in deprecated license and exception lists to determine if it is a supported SPDX id
+func (exp *expressionStream) deprecatedLicenseLookup(license string) *token {
+	deprecated, preferredLicense := exp.licenses.deprecatedLicense(license)
 	if deprecated {
 		return &token{role: licenseToken, value: preferredLicense}
 	}
+	deprecated, preferredLicense = exp.licenses.deprecatedException(license)
+	if deprecated {
+		return &token{role: exceptionToken, value: preferredLicense}
+	}
 	return nil
 }
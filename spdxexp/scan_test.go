@@ -17,56 +17,99 @@ func TestScan(t *testing.T) {
 	}{
 		{"single license", "MIT",
 			[]token{
-				{role: licenseToken, value: "MIT"},
+				{role: licenseToken, value: "MIT", start: 0, end: 3},
 			}, nil},
 		{"single license - diff case", "mit",
 			[]token{
-				{role: licenseToken, value: "MIT"},
+				{role: licenseToken, value: "MIT", start: 0, end: 3},
 			}, nil},
 		{"empty expression", "", []token(nil), nil},
 		{"invalid license", "NON-EXISTENT-LICENSE", []token(nil),
 			errors.New("unknown license 'NON-EXISTENT-LICENSE' at offset 0")},
 		{"two licenses using AND", "MIT AND Apache-2.0",
 			[]token{
-				{role: licenseToken, value: "MIT"},
-				{role: operatorToken, value: "AND"},
-				{role: licenseToken, value: "Apache-2.0"},
+				{role: licenseToken, value: "MIT", start: 0, end: 3},
+				{role: operatorToken, value: "AND", start: 4, end: 7},
+				{role: licenseToken, value: "Apache-2.0", start: 8, end: 18},
 			}, nil},
 		{"two licenses using OR inside paren", "(MIT OR Apache-2.0)",
 			[]token{
-				{role: operatorToken, value: "("},
-				{role: licenseToken, value: "MIT"},
-				{role: operatorToken, value: "OR"},
-				{role: licenseToken, value: "Apache-2.0"},
-				{role: operatorToken, value: ")"},
+				{role: operatorToken, value: "(", start: 0, end: 1},
+				{role: licenseToken, value: "MIT", start: 1, end: 4},
+				{role: operatorToken, value: "OR", start: 5, end: 7},
+				{role: licenseToken, value: "Apache-2.0", start: 8, end: 18},
+				{role: operatorToken, value: ")", start: 18, end: 19},
+			}, nil},
+		{"-or-later scanned as plus", "(Apache-1.0-or-later) AND MIT",
+			[]token{
+				{role: operatorToken, value: "(", start: 0, end: 1},
+				{role: licenseToken, value: "Apache-1.0", start: 1, end: 11},
+				{role: operatorToken, value: "+", start: 11, end: 20},
+				{role: operatorToken, value: ")", start: 20, end: 21},
+				{role: operatorToken, value: "AND", start: 22, end: 25},
+				{role: licenseToken, value: "MIT", start: 26, end: 29},
+			}, nil},
+		{"+ scanned as -or-later", "GPL-2.0+ OR MIT",
+			[]token{
+				{role: licenseToken, value: "GPL-2.0-or-later", start: 0, end: 8},
+				{role: operatorToken, value: "OR", start: 9, end: 11},
+				{role: licenseToken, value: "MIT", start: 12, end: 15},
+			}, nil},
+		{"addition refs", "LicenseRef-X WITH AdditionRef-Y OR MIT WITH DocumentRef-D:AdditionRef-Z",
+			[]token{
+				{role: licenseRefToken, value: "X", start: 0, end: 12},
+				{role: operatorToken, value: "WITH", start: 13, end: 17},
+				{role: additionRefToken, value: "Y", start: 18, end: 31},
+				{role: operatorToken, value: "OR", start: 32, end: 34},
+				{role: licenseToken, value: "MIT", start: 35, end: 38},
+				{role: operatorToken, value: "WITH", start: 39, end: 43},
+				{role: documentRefToken, value: "D", start: 44, end: 57},
+				{role: operatorToken, value: ":", start: 57, end: 58},
+				{role: additionRefToken, value: "Z", start: 58, end: 71},
+			}, nil},
+		{"lowercase operators and whitespace", "mit\tand\r\n(apache-2.0 or\u00a0ISC)",
+			[]token{
+				{role: licenseToken, value: "MIT", start: 0, end: 3},
+				{role: operatorToken, value: "AND", start: 4, end: 7},
+				{role: operatorToken, value: "(", start: 9, end: 10},
+				{role: licenseToken, value: "Apache-2.0", start: 10, end: 20},
+				{role: operatorToken, value: "OR", start: 21, end: 23},
+				{role: licenseToken, value: "ISC", start: 25, end: 28},
+				{role: operatorToken, value: ")", start: 28, end: 29},
+			}, nil},
+		{"mixed case operator", "MIT Or ISC", nil,
+			errors.New("unknown license 'Or' at offset 4")},
+		{"special value", "noassertion",
+			[]token{
+				{role: specialValueToken, value: "NOASSERTION", start: 0, end: 11},
 			}, nil},
 		{"kitchen sink", "   (MIT AND Apache-1.0+)   OR   DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2 OR (GPL-2.0 WITH Bison-exception-2.2)",
 			[]token{
-				{role: operatorToken, value: "("},
-				{role: licenseToken, value: "MIT"},
-				{role: operatorToken, value: "AND"},
-				{role: licenseToken, value: "Apache-1.0"},
-				{role: operatorToken, value: "+"},
-				{role: operatorToken, value: ")"},
-				{role: operatorToken, value: "OR"},
-				{role: documentRefToken, value: "spdx-tool-1.2"},
-				{role: operatorToken, value: ":"},
-				{role: licenseRefToken, value: "MIT-Style-2"},
-				{role: operatorToken, value: "OR"},
-				{role: operatorToken, value: "("},
-				{role: licenseToken, value: "GPL-2.0"},
-				{role: operatorToken, value: "WITH"},
-				{role: exceptionToken, value: "Bison-exception-2.2"},
-				{role: operatorToken, value: ")"},
+				{role: operatorToken, value: "(", start: 3, end: 4},
+				{role: licenseToken, value: "MIT", start: 4, end: 7},
+				{role: operatorToken, value: "AND", start: 8, end: 11},
+				{role: licenseToken, value: "Apache-1.0", start: 12, end: 22},
+				{role: operatorToken, value: "+", start: 22, end: 23},
+				{role: operatorToken, value: ")", start: 23, end: 24},
+				{role: operatorToken, value: "OR", start: 27, end: 29},
+				{role: documentRefToken, value: "spdx-tool-1.2", start: 32, end: 57},
+				{role: operatorToken, value: ":", start: 57, end: 58},
+				{role: licenseRefToken, value: "MIT-Style-2", start: 58, end: 80},
+				{role: operatorToken, value: "OR", start: 81, end: 83},
+				{role: operatorToken, value: "(", start: 84, end: 85},
+				{role: licenseToken, value: "GPL-2.0", start: 85, end: 92},
+				{role: operatorToken, value: "WITH", start: 93, end: 97},
+				{role: exceptionToken, value: "Bison-exception-2.2", start: 98, end: 117},
+				{role: operatorToken, value: ")", start: 117, end: 118},
 			}, nil},
 	}
 
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			tokens, err := scan(test.expression)
+			tokens, err := scan(test.expression, nil)
 
-			require.Equal(t, test.err, err)
+			requireEqualError(t, test.err, err)
 			assert.Equal(t, test.tokens, tokens)
 		})
 	}
@@ -101,19 +144,19 @@ func TestParseToken(t *testing.T) {
 		err      error
 	}{
 		{"operator found", getExpressionStream("MIT AND Apache-2.0", 4),
-			&token{role: operatorToken, value: "AND"}, 7, nil},
+			&token{role: operatorToken, value: "AND", start: 4, end: 7}, 7, nil},
 		{"operator error", getExpressionStream("Apache-1.0 + OR MIT", 11),
 			nil, 11, errors.New("unexpected space before +")},
 		{"document ref found", getExpressionStream("DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2", 0),
-			&token{role: documentRefToken, value: "spdx-tool-1.2"}, 25, nil},
+			&token{role: documentRefToken, value: "spdx-tool-1.2", start: 0, end: 25}, 25, nil},
 		{"document ref error", getExpressionStream("DocumentRef-!23", 0),
 			nil, 12, errors.New("expected id at offset 12")},
 		{"license ref found", getExpressionStream("DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2", 26),
-			&token{role: licenseRefToken, value: "MIT-Style-2"}, 48, nil},
+			&token{role: licenseRefToken, value: "MIT-Style-2", start: 26, end: 48}, 48, nil},
 		{"license ref error", getExpressionStream("LicenseRef-!23", 0),
 			nil, 11, errors.New("expected id at offset 11")},
 		{"identifier found", getExpressionStream("MIT AND Apache-2.0", 8),
-			&token{role: licenseToken, value: "Apache-2.0"}, 18, nil},
+			&token{role: licenseToken, value: "Apache-2.0", start: 8, end: 18}, 18, nil},
 		{"identifier error", getExpressionStream("NON-EXISTENT-LICENSE", 0),
 			nil, 0, errors.New("unknown license 'NON-EXISTENT-LICENSE' at offset 0")},
 	}
@@ -124,7 +167,7 @@ func TestParseToken(t *testing.T) {
 			tokn := test.exp.parseToken()
 			assert.Equal(t, test.newIndex, test.exp.index)
 
-			require.Equal(t, test.err, test.exp.err)
+			requireEqualError(t, test.err, test.exp.err)
 			if test.err != nil {
 				// token is nil when error occurs or token is not recognized
 				var nilToken *token
@@ -220,23 +263,31 @@ func TestReadOperator(t *testing.T) {
 		err      error
 	}{
 		{"WITH operator", getExpressionStream("MIT WITH Bison-exception-2.2", 4),
-			&token{role: operatorToken, value: "WITH"}, 8, nil},
+			&token{role: operatorToken, value: "WITH", start: 4, end: 8}, 8, nil},
 		{"AND operator", getExpressionStream("MIT AND Apache-2.0", 4),
-			&token{role: operatorToken, value: "AND"}, 7, nil},
+			&token{role: operatorToken, value: "AND", start: 4, end: 7}, 7, nil},
 		{"OR operator", getExpressionStream("MIT OR Apache-2.0", 4),
-			&token{role: operatorToken, value: "OR"}, 6, nil},
+			&token{role: operatorToken, value: "OR", start: 4, end: 6}, 6, nil},
 		{"( operator", getExpressionStream("(MIT OR Apache-2.0)", 0),
-			&token{role: operatorToken, value: "("}, 1, nil},
+			&token{role: operatorToken, value: "(", start: 0, end: 1}, 1, nil},
 		{") operator", getExpressionStream("(MIT OR Apache-2.0)", 18),
-			&token{role: operatorToken, value: ")"}, 19, nil},
+			&token{role: operatorToken, value: ")", start: 18, end: 19}, 19, nil},
 		{": operator", getExpressionStream("DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2", 25),
-			&token{role: operatorToken, value: ":"}, 26, nil},
+			&token{role: operatorToken, value: ":", start: 25, end: 26}, 26, nil},
 		{"plus operator - correctly used", getExpressionStream("Apache-1.0+ OR MIT", 10),
-			&token{role: operatorToken, value: "+"}, 11, nil},
+			&token{role: operatorToken, value: "+", start: 10, end: 11}, 11, nil},
 		{"plus operator - with preceding space", getExpressionStream("Apache-1.0 + OR MIT", 11),
 			nil, 11, errors.New("unexpected space before +")},
 		{"operator not found", getExpressionStream("MIT AND Apache-2.0", 8),
 			nil, 8, nil},
+		{"lowercase operator", getExpressionStream("mit with bison-exception-2.2", 4),
+			&token{role: operatorToken, value: "WITH", start: 4, end: 8}, 8, nil},
+		{"mixed case operator not found", getExpressionStream("MIT And Apache-2.0", 4),
+			nil, 4, nil},
+		{"lowercase operator prefix of id not found", getExpressionStream("MIT AND oracle", 8),
+			nil, 8, nil},
+		{"plus operator - with preceding tab", getExpressionStream("Apache-1.0\t+ OR MIT", 11),
+			nil, 11, errors.New("unexpected space before +")},
 	}
 
 	for _, test := range tests {
@@ -244,7 +295,7 @@ func TestReadOperator(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			operator := test.exp.readOperator()
 			assert.Equal(t, test.newIndex, test.exp.index)
-			require.Equal(t, test.err, test.exp.err)
+			requireEqualError(t, test.err, test.exp.err)
 			assert.Equal(t, test.operator, operator)
 		})
 	}
@@ -284,7 +335,7 @@ func TestReadDocumentRef(t *testing.T) {
 		newIndex int
 		err      error
 	}{
-		{"valid document ref with id", getExpressionStream("DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2", 0), &token{role: documentRefToken, value: "spdx-tool-1.2"}, 25, nil},
+		{"valid document ref with id", getExpressionStream("DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2", 0), &token{role: documentRefToken, value: "spdx-tool-1.2", start: 0, end: 25}, 25, nil},
 		{"document ref not found", getExpressionStream("DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2", 26), nil, 26, nil},
 		{"invalid document ref with bad id", getExpressionStream("DocumentRef-!23", 0), nil, 12, errors.New("expected id at offset 12")},
 	}
@@ -295,7 +346,7 @@ func TestReadDocumentRef(t *testing.T) {
 			ref := test.exp.readDocumentRef()
 			assert.Equal(t, test.newIndex, test.exp.index)
 
-			require.Equal(t, test.err, test.exp.err)
+			requireEqualError(t, test.err, test.exp.err)
 			if test.err != nil {
 				// ref should be nil when error occurs or a ref is not found
 				var nilToken *token
@@ -317,7 +368,7 @@ func TestReadLicenseRef(t *testing.T) {
 		newIndex int
 		err      error
 	}{
-		{"valid license ref with id", getExpressionStream("DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2", 26), &token{role: licenseRefToken, value: "MIT-Style-2"}, 48, nil},
+		{"valid license ref with id", getExpressionStream("DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2", 26), &token{role: licenseRefToken, value: "MIT-Style-2", start: 26, end: 48}, 48, nil},
 		{"license ref not found", getExpressionStream("DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2", 0), nil, 0, nil},
 		{"invalid license ref with bad id", getExpressionStream("LicenseRef-!23", 0), nil, 11, errors.New("expected id at offset 11")},
 	}
@@ -328,7 +379,40 @@ func TestReadLicenseRef(t *testing.T) {
 			ref := test.exp.readLicenseRef()
 			assert.Equal(t, test.newIndex, test.exp.index)
 
-			require.Equal(t, test.err, test.exp.err)
+			requireEqualError(t, test.err, test.exp.err)
+			if test.err != nil {
+				// ref should be nil when error occurs or a ref is not found
+				var nilToken *token
+				assert.Equal(t, nilToken, ref)
+				return
+			}
+
+			// ref found, check ref value
+			assert.Equal(t, test.ref, ref)
+		})
+	}
+}
+
+func TestReadAdditionRef(t *testing.T) {
+	tests := []struct {
+		name     string
+		exp      *expressionStream
+		ref      *token
+		newIndex int
+		err      error
+	}{
+		{"valid addition ref with id", getExpressionStream("LicenseRef-X WITH AdditionRef-Custom-1.0", 18), &token{role: additionRefToken, value: "Custom-1.0", start: 18, end: 40}, 40, nil},
+		{"addition ref not found", getExpressionStream("LicenseRef-X WITH AdditionRef-Custom-1.0", 0), nil, 0, nil},
+		{"invalid addition ref with bad id", getExpressionStream("AdditionRef-!23", 0), nil, 12, errors.New("expected id at offset 12")},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			ref := test.exp.readAdditionRef()
+			assert.Equal(t, test.newIndex, test.exp.index)
+
+			requireEqualError(t, test.err, test.exp.err)
 			if test.err != nil {
 				// ref should be nil when error occurs or a ref is not found
 				var nilToken *token
@@ -351,14 +435,15 @@ func TestReadLicense(t *testing.T) {
 		newIndex      int
 		err           error
 	}{
-		{"active license", getExpressionStream("MIT", 0), &token{role: licenseToken, value: "MIT"}, "MIT", 3, nil},
-		{"active -or-later", getExpressionStream("AGPL-1.0-or-later", 0), &token{role: licenseToken, value: "AGPL-1.0-or-later"}, "AGPL-1.0-or-later", 17, nil},
-		{"active -or-later using +", getExpressionStream("AGPL-1.0+", 0), &token{role: licenseToken, value: "AGPL-1.0-or-later"}, "AGPL-1.0+", 9, nil}, // no valid example for this; all that include -or-later have the base as a deprecated license
-		{"active -or-later not in list", getExpressionStream("Apache-1.0-or-later", 0), &token{role: licenseToken, value: "Apache-1.0"}, "Apache-1.0+", 10, nil},
-		{"active -only", getExpressionStream("GPL-2.0-only", 0), &token{role: licenseToken, value: "GPL-2.0-only"}, "GPL-2.0-only", 12, nil},
-		{"active -only not in list", getExpressionStream("ECL-1.0-only", 0), &token{role: licenseToken, value: "ECL-1.0"}, "ECL-1.0-only", 12, nil},
-		{"deprecated license", getExpressionStream("LGPL-2.1", 0), &token{role: licenseToken, value: "LGPL-2.1"}, "LGPL-2.1", 8, nil},
-		{"exception license", getExpressionStream("GPL-CC-1.0", 0), &token{role: exceptionToken, value: "GPL-CC-1.0"}, "GPL-CC-1.0", 10, nil},
+		{"active license", getExpressionStream("MIT", 0), &token{role: licenseToken, value: "MIT", start: 0, end: 3}, "MIT", 3, nil},
+		{"active -or-later", getExpressionStream("AGPL-1.0-or-later", 0), &token{role: licenseToken, value: "AGPL-1.0-or-later", start: 0, end: 17}, "AGPL-1.0-or-later", 17, nil},
+		{"active -or-later using +", getExpressionStream("AGPL-1.0+", 0), &token{role: licenseToken, value: "AGPL-1.0-or-later", start: 0, end: 9}, "AGPL-1.0+", 9, nil}, // no valid example for this; all that include -or-later have the base as a deprecated license
+		{"active -or-later not in list", getExpressionStream("Apache-1.0-or-later", 0), &token{role: licenseToken, value: "Apache-1.0", start: 0, end: 10}, "Apache-1.0-or-later", 19, nil},
+		{"active -only", getExpressionStream("GPL-2.0-only", 0), &token{role: licenseToken, value: "GPL-2.0-only", start: 0, end: 12}, "GPL-2.0-only", 12, nil},
+		{"active -only not in list", getExpressionStream("ECL-1.0-only", 0), &token{role: licenseToken, value: "ECL-1.0", start: 0, end: 12}, "ECL-1.0-only", 12, nil},
+		{"deprecated license", getExpressionStream("LGPL-2.1", 0), &token{role: licenseToken, value: "LGPL-2.1", start: 0, end: 8}, "LGPL-2.1", 8, nil},
+		{"exception license", getExpressionStream("GPL-CC-1.0", 0), &token{role: exceptionToken, value: "GPL-CC-1.0", start: 0, end: 10}, "GPL-CC-1.0", 10, nil},
+		{"deprecated exception license", getExpressionStream("nokia-qt-exception-1.1", 0), &token{role: exceptionToken, value: "Nokia-Qt-exception-1.1", start: 0, end: 22}, "nokia-qt-exception-1.1", 22, nil},
 		{"invalid license", getExpressionStream("NON-EXISTENT-LICENSE", 0), nil, "NON-EXISTENT-LICENSE", 0, errors.New("unknown license 'NON-EXISTENT-LICENSE' at offset 0")},
 	}
 
@@ -368,7 +453,7 @@ func TestReadLicense(t *testing.T) {
 			license := test.exp.readLicense()
 			assert.Equal(t, test.newIndex, test.exp.index)
 
-			require.Equal(t, test.err, test.exp.err)
+			requireEqualError(t, test.err, test.exp.err)
 			if test.err != nil {
 				// license should be nil when error occurs or a license is not found
 				var nilToken *token
@@ -383,6 +468,15 @@ func TestReadLicense(t *testing.T) {
 	}
 }
 
+func TestReadLicenseOrLaterPending(t *testing.T) {
+	// -or-later is scanned as a + operator that spans the suffix in the original expression
+	exp := getExpressionStream("Apache-1.0-or-later", 0)
+	license := exp.readLicense()
+	require.NoError(t, exp.err)
+	assert.Equal(t, &token{role: licenseToken, value: "Apache-1.0", start: 0, end: 10}, license)
+	assert.Equal(t, &token{role: operatorToken, value: "+", start: 10, end: 19}, exp.pending)
+}
+
 func getExpressionStream(expression string, index int) *expressionStream {
 	return &expressionStream{
 		expression: expression,
@@ -0,0 +1,165 @@
+package spdxexp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Simplify parses an SPDX license expression and returns an equivalent expression with redundant
+// licenses and sub-expressions removed.  See Expression.Simplify for the rules that are applied.
+// The expression is parsed with the license list embedded in the spdxlicenses package; use
+// ParseWithOptions and Expression.Simplify to parse it with another list.
+// Returns error if the expression cannot be parsed.
+func Simplify(expression string) (string, error) {
+	parsed, err := Parse(expression)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Simplify().String(), nil
+}
+
+// Simplify returns an equivalent expression with redundant licenses and sub-expressions removed:
+//   - nested AND and OR expressions are flattened (e.g. "(MIT OR ISC) OR MIT" is "MIT OR ISC OR MIT")
+//   - duplicate licenses and sub-expressions are removed (e.g. "MIT OR ISC OR MIT" is "MIT OR ISC"),
+//     ignoring the order of the licenses in a sub-expression
+//   - sub-expressions that are absorbed by another operand are removed (e.g. "MIT OR (MIT AND ISC)"
+//     and "MIT AND (MIT OR ISC)" are "MIT")
+//
+// Licenses in the same range are duplicates (e.g. "GPL-2.0" and "GPL-2.0-only"), as determined by
+// compareEQ, when they have the same + and exception.  The first of the duplicate licenses or
+// sub-expressions is kept, and the order of the remaining operands is not changed.
+func (e *Expression) Simplify() *Expression {
+	if e == nil {
+		return nil
+	}
+	return newExpression(simplifyNode(e.node).node, e.spans)
+}
+
+// simplified is a node that has been simplified.
+type simplified struct {
+	node *node
+	// key is the same for equivalent nodes (e.g. "GPL-2.0" and "GPL-2.0-only", "MIT OR ISC" and
+	// "ISC OR MIT").
+	key string
+	// operands of an AND or OR expression, with nested expressions of the same conjunction flattened
+	operands []*simplified
+}
+
+func simplifyNode(n *node) *simplified {
+	if !n.isExpression() {
+		return &simplified{node: n, key: simplifyKey(n)}
+	}
+
+	conjunction := *n.conjunction()
+	var operands []*simplified
+	for _, child := range []*node{n.left(), n.right()} {
+		s := simplifyNode(child)
+		if s.node.isExpression() && *s.node.conjunction() == conjunction {
+			operands = append(operands, s.operands...)
+		} else {
+			operands = append(operands, s)
+		}
+	}
+	operands = absorb(dedupSimplified(operands))
+	if len(operands) == 1 {
+		return operands[0]
+	}
+
+	var joined *node
+	keys := make([]string, len(operands))
+	for i := len(operands) - 1; i >= 0; i-- {
+		joined = joinNodes(operands[i].node, conjunction, joined)
+		keys[i] = operands[i].key
+	}
+	sort.Strings(keys)
+	return &simplified{
+		node:     joined,
+		key:      conjunction + "(" + strings.Join(keys, ",") + ")",
+		operands: operands,
+	}
+}
+
+// Return the key of a license, license ref, or special value.  Licenses in the same range have
+// the same key when they have the same + and exception, matching compareEQ.  The range is looked
+// up once per license, since comparing every pair of licenses is expensive.
+func simplifyKey(n *node) string {
+	if n.isLicense() {
+		if r := getLicenseRange(*n.license()); r != nil {
+			key := fmt.Sprintf("range-%d-%d", r.location[licenseGroup], r.location[versionGroup])
+			if n.hasPlus() {
+				key += "+"
+			}
+			if n.hasException() {
+				key += " WITH " + *n.exception()
+			}
+			return key
+		}
+	}
+	return *n.reconstructedLicenseString()
+}
+
+// Remove operands that have the same key as an earlier operand.
+func dedupSimplified(operands []*simplified) []*simplified {
+	seen := make(map[string]struct{}, len(operands))
+	deduped := operands[:0]
+	for _, operand := range operands {
+		if _, ok := seen[operand.key]; ok {
+			continue
+		}
+		seen[operand.key] = struct{}{}
+		deduped = append(deduped, operand)
+	}
+	return deduped
+}
+
+// Remove operands that are absorbed by another operand of the same expression.  In an OR
+// expression, an AND operand is absorbed by an operand whose licenses are a subset of its
+// licenses (e.g. "MIT OR (MIT AND ISC)" is "MIT").  Likewise, in an AND expression, an OR operand
+// is absorbed by an operand whose licenses are a subset of its licenses.  Operands must be deduped.
+func absorb(operands []*simplified) []*simplified {
+	if len(operands) < 2 {
+		return operands
+	}
+
+	// the keys of the operands of each operand, or the key of the operand itself if it is a license
+	sets := make([]map[string]struct{}, len(operands))
+	for i, operand := range operands {
+		sets[i] = map[string]struct{}{}
+		if operand.operands == nil {
+			sets[i][operand.key] = struct{}{}
+			continue
+		}
+		for _, o := range operand.operands {
+			sets[i][o.key] = struct{}{}
+		}
+	}
+
+	absorbed := make([]bool, len(operands))
+	for i := range operands {
+		for j := range operands {
+			if i != j && !absorbed[j] && len(sets[j]) < len(sets[i]) && isSubset(sets[j], sets[i]) {
+				absorbed[i] = true
+				break
+			}
+		}
+	}
+
+	kept := operands[:0]
+	for i, operand := range operands {
+		if !absorbed[i] {
+			kept = append(kept, operand)
+		}
+	}
+	return kept
+}
+
+// Return true if every key in subset is also in set.
+func isSubset(subset, set map[string]struct{}) bool {
+	for key := range subset {
+		if _, ok := set[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
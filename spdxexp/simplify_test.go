@@ -0,0 +1,78 @@
+package spdxexp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimplify(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		simplified string
+		err        error
+	}{
+		{"single license", "MIT", "MIT", nil},
+		{"special value", "NOASSERTION", "NOASSERTION", nil},
+		{"already simple", "(MIT OR ISC) AND Apache-2.0", "(MIT OR ISC) AND Apache-2.0", nil},
+		{"idempotent AND", "MIT AND MIT", "MIT", nil},
+		{"idempotent OR", "MIT OR mit", "MIT", nil},
+		{"flattened and deduped", "(MIT OR ISC) OR MIT", "MIT OR ISC", nil},
+		{"nested flattened and deduped", "MIT AND (ISC AND (MIT AND Apache-2.0))", "MIT AND ISC AND Apache-2.0", nil},
+		{"OR absorbs AND", "MIT OR (MIT AND Apache-2.0)", "MIT", nil},
+		{"AND absorbs OR", "MIT AND (Apache-2.0 OR MIT)", "MIT", nil},
+		{"absorbed by expression", "(MIT AND ISC) OR (ISC AND Apache-2.0 AND MIT) OR BSD-2-Clause", "MIT AND ISC OR BSD-2-Clause", nil},
+		{"not absorbed", "MIT OR ISC AND (MIT OR Apache-2.0)", "MIT OR ISC AND (MIT OR Apache-2.0)", nil},
+		{"duplicate sub-expressions in any order", "(MIT OR ISC) AND (ISC OR MIT)", "MIT OR ISC", nil},
+		{"simplified sub-expression is flattened", "(MIT OR MIT) AND ISC AND (Apache-2.0 AND MIT)", "MIT AND ISC AND Apache-2.0", nil},
+		{"same range", "GPL-2.0 AND GPL-2.0-only", "GPL-2.0", nil},
+		{"same range with plus", "GPL-2.0+ OR GPL-2.0-or-later", "GPL-2.0-or-later", nil},
+		{"same range without plus", "GPL-2.0-only OR GPL-2.0-or-later", "GPL-2.0-only OR GPL-2.0-or-later", nil},
+		{"same range with exception", "GPL-2.0-only WITH Classpath-exception-2.0 OR GPL-2.0 WITH Classpath-exception-2.0",
+			"GPL-2.0-only WITH Classpath-exception-2.0", nil},
+		{"same range without exception", "GPL-2.0-only WITH Classpath-exception-2.0 OR GPL-2.0",
+			"GPL-2.0-only WITH Classpath-exception-2.0 OR GPL-2.0", nil},
+		{"different versions", "Apache-1.0 OR Apache-2.0", "Apache-1.0 OR Apache-2.0", nil},
+		{"license refs", "LicenseRef-X AND (LicenseRef-X OR DocumentRef-d:LicenseRef-X)", "LicenseRef-X", nil},
+		{"document refs are different", "LicenseRef-X AND DocumentRef-d:LicenseRef-X", "LicenseRef-X AND DocumentRef-d:LicenseRef-X", nil},
+		{"invalid expression", "MIT AND", "", errors.New("expected expression following AND, but found none")},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			simplified, err := Simplify(test.expression)
+			requireEqualError(t, test.err, err)
+			assert.Equal(t, test.simplified, simplified)
+			if test.err != nil {
+				return
+			}
+
+			// simplifying is idempotent and the result is a valid expression
+			again, err := Simplify(simplified)
+			require.NoError(t, err)
+			assert.Equal(t, simplified, again)
+		})
+	}
+}
+
+func TestExpressionSimplify(t *testing.T) {
+	source := "MIT AND (ISC OR MIT)"
+	expression, err := Parse(source)
+	require.NoError(t, err)
+
+	simplified := expression.Simplify()
+	assert.Equal(t, "MIT", simplified.String())
+	span, ok := simplified.Span()
+	require.True(t, ok)
+	assert.Equal(t, "MIT", source[span.Start:span.End])
+
+	// the original expression is not changed
+	assert.Equal(t, source, expression.String())
+
+	var nilExpression *Expression
+	assert.Nil(t, nilExpression.Simplify())
+}
@@ -2,6 +2,10 @@
 Package spdxlicenses provides functions to get licenses, deprecated licenses,
 and exceptions. These are auto-generated and should not be modified directly.
 Licenses are generated from the [SPDX official machine readable license list].
+The metadata of each license, such as its name and OSI approval, is available
+through Lookup, and the metadata of each exception through LookupException.
+Version returns the version of the license list that the package is generated
+from.
 
 In addition, this package includes a function to return license ranges for
 sequential licenses and ranges including modifiers (i.e. -only, -or-later).
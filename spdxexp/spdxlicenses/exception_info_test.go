@@ -0,0 +1,58 @@
+package spdxlicenses
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupException(t *testing.T) {
+	tests := []struct {
+		name  string
+		id    string
+		info  ExceptionInfo
+		found bool
+	}{
+		{"active exception", "LLVM-exception", ExceptionInfo{
+			ID:   "LLVM-exception",
+			Name: "LLVM Exception",
+			SeeAlso: []string{
+				"http://llvm.org/foundation/relicensing/LICENSE.txt",
+				"https://web.archive.org/web/20240423023852/https://foundation.llvm.org/relicensing/LICENSE.txt",
+			},
+			UsedWith:  []string{"Apache-2.0"},
+			Reference: "https://spdx.org/licenses/LLVM-exception.html",
+		}, true},
+		{"deprecated exception ignoring case", "nokia-qt-exception-1.1", ExceptionInfo{
+			ID:           "Nokia-Qt-exception-1.1",
+			Name:         "Nokia Qt LGPL exception 1.1",
+			IsDeprecated: true,
+			SeeAlso: []string{
+				"https://www.keepassx.org/dev/projects/keepassx/repository/revisions/b8dfb9cc4d5133e0f09cd7533d15a4f1c19a40f2/entry/LICENSE.NOKIA-LGPL-EXCEPTION",
+			},
+			UsedWith:  []string{"LGPL-2.1-only"},
+			Reference: "https://spdx.org/licenses/Nokia-Qt-exception-1.1.html",
+		}, true},
+		{"unknown exception", "NOT-AN-EXCEPTION", ExceptionInfo{}, false},
+		{"license", "MIT", ExceptionInfo{}, false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			info, found := LookupException(test.id)
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.info, info)
+		})
+	}
+}
+
+func TestLookupExceptionCoversAllExceptions(t *testing.T) {
+	for _, id := range append(GetExceptions(), GetDeprecatedExceptions()...) {
+		info, found := LookupException(id)
+		assert.True(t, found, id)
+		assert.Equal(t, id, info.ID)
+		deprecated, _ := IsDeprecatedException(id)
+		assert.Equal(t, deprecated, info.IsDeprecated, id)
+	}
+}
@@ -0,0 +1,36 @@
+package spdxlicenses
+
+// Code generated by go-spdx cmd/exceptions.go. DO NOT EDIT.
+// Source: https://github.com/spdx/license-list-data specifies official SPDX license list.
+
+import "strings"
+
+// IsDeprecatedException does a case-insensitive lookup for the exception id in the deprecated exceptions map.
+// It returns true and the case-sensitive ID if found, otherwise false and the original id.
+func IsDeprecatedException(id string) (bool, string) {
+	foundID, ok := deprecatedExceptionsMap[strings.ToUpper(id)]
+	if ok {
+		return true, foundID
+	}
+	return false, id
+}
+
+// GetDeprecatedExceptionsMap returns a map of deprecated exception license IDs keyed by uppercase ID.
+func GetDeprecatedExceptionsMap() map[string]string {
+	copied := make(map[string]string, len(deprecatedExceptionsMap))
+	for k, v := range deprecatedExceptionsMap {
+		copied[k] = v
+	}
+	return copied
+}
+
+// GetDeprecatedExceptions returns a slice of deprecated exception license IDs.
+func GetDeprecatedExceptions() []string {
+	return []string{
+		"Nokia-Qt-exception-1.1",
+	}
+}
+
+var deprecatedExceptionsMap = map[string]string{
+	"NOKIA-QT-EXCEPTION-1.1": "Nokia-Qt-exception-1.1",
+}
@@ -0,0 +1,67 @@
+package spdxlicenses
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name  string
+		id    string
+		info  LicenseInfo
+		found bool
+	}{
+		{"active license", "MIT", LicenseInfo{
+			ID:            "MIT",
+			Name:          "MIT License",
+			IsOsiApproved: true,
+			IsFsfLibre:    true,
+			SeeAlso: []string{
+				"https://opensource.org/license/MIT",
+				"https://gitlab.freedesktop.org/xorg/xserver/-/blob/dd5c2595a42d3ff0c4f18d9b53d1f6c3fd934fd4/COPYING#L365-389",
+			},
+			Reference: "https://spdx.org/licenses/MIT.html",
+		}, true},
+		{"deprecated license ignoring case", "gpl-2.0", LicenseInfo{
+			ID:            "GPL-2.0",
+			Name:          "GNU General Public License v2.0 only",
+			IsOsiApproved: true,
+			IsFsfLibre:    true,
+			IsDeprecated:  true,
+			SeeAlso: []string{
+				"https://www.gnu.org/licenses/old-licenses/gpl-2.0-standalone.html",
+				"https://opensource.org/license/GPL-2.0",
+			},
+			Reference: "https://spdx.org/licenses/GPL-2.0.html",
+		}, true},
+		{"unknown license", "NOT-A-LICENSE", LicenseInfo{}, false},
+		{"exception", "Classpath-exception-2.0", LicenseInfo{}, false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			info, found := Lookup(test.id)
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.info, info)
+		})
+	}
+}
+
+func TestLookupCoversAllLicenses(t *testing.T) {
+	for _, id := range append(GetLicenses(), GetDeprecated()...) {
+		info, found := Lookup(id)
+		assert.True(t, found, id)
+		assert.Equal(t, id, info.ID)
+	}
+}
+
+func TestLookupReturnsCopy(t *testing.T) {
+	info, _ := Lookup("MIT")
+	info.SeeAlso[0] = "changed"
+
+	info, _ = Lookup("MIT")
+	assert.Equal(t, "https://opensource.org/license/MIT", info.SeeAlso[0])
+}
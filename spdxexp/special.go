@@ -0,0 +1,63 @@
+package spdxexp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The special values that SPDX documents use in place of a license expression.  NONE means that
+// there is no license and NOASSERTION means that the license was not determined.
+const (
+	noneValue        = "NONE"
+	noAssertionValue = "NOASSERTION"
+)
+
+// SpecialValueHandling controls how the special values NONE and NOASSERTION are treated.  The
+// special values are only recognized as the entire expression (e.g. "NOASSERTION", but not
+// "MIT OR NOASSERTION").  SPDX writes them in uppercase, but like license ids and operators, they
+// are recognized in any case (e.g. "noassertion") and normalized to uppercase, since declared
+// licenses are often not written exactly.
+type SpecialValueHandling uint8
+
+const (
+	// RejectSpecialValues treats NONE and NOASSERTION as invalid.  This is the default.
+	RejectSpecialValues SpecialValueHandling = iota
+	// ReviewSpecialValues treats NONE and NOASSERTION as valid, but reports that they need to be
+	// reviewed before they can be accepted.
+	ReviewSpecialValues
+	// AllowSpecialValues treats NONE and NOASSERTION as valid.
+	AllowSpecialValues
+)
+
+var (
+	// ErrSpecialValueRejected is returned for NONE and NOASSERTION when special values are
+	// rejected.
+	ErrSpecialValueRejected = errors.New("special value is not allowed")
+	// ErrSpecialValueNeedsReview is returned for NONE and NOASSERTION when special values need
+	// review.
+	ErrSpecialValueNeedsReview = errors.New("special value needs review")
+)
+
+// Return the error for a special value as controlled by the handling, or nil if it is allowed.
+func (h SpecialValueHandling) check(value string) error {
+	switch h {
+	case AllowSpecialValues:
+		return nil
+	case ReviewSpecialValues:
+		return fmt.Errorf("%w: %s", ErrSpecialValueNeedsReview, value)
+	}
+	return fmt.Errorf("%w: %s", ErrSpecialValueRejected, value)
+}
+
+// specialValue returns the normalized special value (i.e. "NONE" or "NOASSERTION") and true if the
+// id is a special value, ignoring case; otherwise, "" and false.
+func specialValue(id string) (string, bool) {
+	switch {
+	case strings.EqualFold(id, noneValue):
+		return noneValue, true
+	case strings.EqualFold(id, noAssertionValue):
+		return noAssertionValue, true
+	}
+	return "", false
+}
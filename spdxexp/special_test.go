@@ -0,0 +1,33 @@
+package spdxexp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpecialValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		value   string
+		special bool
+	}{
+		{"none", "NONE", "NONE", true},
+		{"no assertion", "NOASSERTION", "NOASSERTION", true},
+		// special values are recognized in any case, like license ids and operators
+		{"lowercase none", "none", "NONE", true},
+		{"mixed case no assertion", "NoAssertion", "NOASSERTION", true},
+		{"license", "MIT", "", false},
+		{"prefix", "NO", "", false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			value, special := specialValue(test.id)
+			assert.Equal(t, test.value, value)
+			assert.Equal(t, test.special, special)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package spdxexp
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxSuggestions is the maximum number of suggestions returned for an unknown license.
+const maxSuggestions = 5
+
+// suggestionTypos are common misspellings in license identifiers and their corrections.  The
+// typo is replaced anywhere in the uppercase identifier.
+var suggestionTypos = []struct {
+	typo       string
+	correction string
+}{
+	{"LICENCE", "LICENSE"},
+	{"APPACHE", "APACHE"},
+	{"APACHI", "APACHE"},
+	{"APACH-", "APACHE-"},
+	{"BDS", "BSD"},
+	{"CLAUSES", "CLAUSE"},
+	{"CLASUE", "CLAUSE"},
+	{"CALUSE", "CLAUSE"},
+	{"LGLP", "LGPL"},
+	{"AGLP", "AGPL"},
+	{"GLP", "GPL"},
+	{"GNU-", ""},
+	{"EXCEPTON", "EXCEPTION"},
+	{"EXEPTION", "EXCEPTION"},
+}
+
+// Ranks of the license tables, used to order suggestions that are equally close.
+const (
+	activeRank uint8 = iota
+	exceptionRank
+	deprecatedRank
+)
+
+type suggestion struct {
+	id       string
+	distance int   // 0 for corrections of known typos and version patterns
+	rank     uint8 // table the id is from
+}
+
+// suggestLicenses returns up to maxSuggestions license and exception ids that are similar to an
+// unknown id, closest first.  Ids that correct a known typo, alias, or version pattern (e.g.
+// "Apache2" for "Apache-2.0") are first, followed by ids ordered by edit distance.  Only ids in the
+// license list, or the default list if it is nil, are suggested.
+func suggestLicenses(id string, licenses *LicenseList) []string {
+	if id == "" {
+		return nil
+	}
+	found := map[string]suggestion{}
+	add := func(s suggestion) {
+		if existing, ok := found[s.id]; !ok || s.distance < existing.distance {
+			found[s.id] = s
+		}
+	}
+
+	upper := strings.ToUpper(id)
+	corrected := []string{upper}
+	for _, t := range suggestionTypos {
+		if strings.Contains(upper, t.typo) {
+			corrected = append(corrected, strings.ReplaceAll(upper, t.typo, t.correction))
+		}
+	}
+	for _, c := range corrected {
+		for _, s := range exactSuggestions(c, licenses) {
+			add(s)
+		}
+	}
+
+	maxDistance := 1
+	switch {
+	case len(upper) > 8:
+		maxDistance = 3
+	case len(upper) > 4:
+		maxDistance = 2
+	}
+	tables := []struct {
+		ids  []string
+		rank uint8
+	}{
+		{licenses.orDefault().licenses.ids, activeRank},
+		{licenses.orDefault().exceptions.ids, exceptionRank},
+		{licenses.orDefault().deprecated.ids, deprecatedRank},
+		{licenses.orDefault().deprecatedExceptions.ids, deprecatedRank},
+	}
+	for _, table := range tables {
+		for _, candidate := range table.ids {
+			upperCandidate := strings.ToUpper(candidate)
+			if strings.HasPrefix(upperCandidate, upper+"-") {
+				// the id without a version or variant (e.g. "Apache" for "Apache-2.0")
+				add(suggestion{id: candidate, distance: 1, rank: table.rank})
+				continue
+			}
+			if distance := editDistance(upper, upperCandidate, maxDistance); distance <= maxDistance {
+				add(suggestion{id: candidate, distance: distance, rank: table.rank})
+			}
+		}
+	}
+
+	ranked := make([]suggestion, 0, len(found))
+	for _, s := range found {
+		ranked = append(ranked, s)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].distance != ranked[j].distance {
+			return ranked[i].distance < ranked[j].distance
+		}
+		if ranked[i].rank != ranked[j].rank {
+			return ranked[i].rank < ranked[j].rank
+		}
+		return ranked[i].id < ranked[j].id
+	})
+	if len(ranked) > maxSuggestions {
+		ranked = ranked[:maxSuggestions]
+	}
+
+	suggestions := make([]string, len(ranked))
+	for i, s := range ranked {
+		suggestions[i] = s.id
+	}
+	return suggestions
+}
+
+// Return the ids that an uppercase id corrects to exactly, using the license tables, the lenient
+// aliases, and version patterns (e.g. "APACHE2" is Apache-2.0 and "GPLV2" is GPL-2.0-only or
+// GPL-2.0-or-later).  Aliases and version patterns are only suggested when they are in the license list.
+func exactSuggestions(upper string, licenses *LicenseList) []suggestion {
+	var suggestions []suggestion
+	if ok, id := licenses.activeLicense(upper); ok {
+		suggestions = append(suggestions, suggestion{id: id, rank: activeRank})
+	}
+	if ok, id := licenses.exceptionLicense(upper); ok {
+		suggestions = append(suggestions, suggestion{id: id, rank: exceptionRank})
+	}
+	if ok, id := licenses.deprecatedLicense(upper); ok {
+		suggestions = append(suggestions, suggestion{id: id, rank: deprecatedRank})
+	}
+	if ok, id := licenses.deprecatedException(upper); ok {
+		suggestions = append(suggestions, suggestion{id: id, rank: deprecatedRank})
+	}
+
+	lower := strings.ToLower(upper)
+	if alias, ok := lenientAliases[lower]; ok && licenses.known(alias.id) {
+		suggestions = append(suggestions, suggestion{id: alias.id, rank: activeRank})
+	}
+	for _, plus := range []bool{false, true} {
+		id, _ := lenientVersionedLicense(lower, plus)
+		if id == "" || strings.HasSuffix(id, "+") || !licenses.known(id) {
+			continue
+		}
+		rank := activeRank
+		if ok, _ := licenses.activeLicense(id); !ok {
+			rank = deprecatedRank
+		}
+		suggestions = append(suggestions, suggestion{id: id, rank: rank})
+	}
+	return suggestions
+}
+
+// Return the edit distance between two strings, counting insertions, deletions, substitutions,
+// and transpositions of adjacent characters as one edit each.  Returns limit+1 if the distance is
+// more than limit.
+func editDistance(a, b string, limit int) int {
+	if len(a)-len(b) > limit || len(b)-len(a) > limit {
+		return limit + 1
+	}
+	beforePrevious := make([]int, len(b)+1)
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		rowMin := current[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				current[j] = min(current[j], beforePrevious[j-2]+1)
+			}
+			rowMin = min(rowMin, current[j])
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+		beforePrevious, previous, current = previous, current, beforePrevious
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,74 @@
+package spdxexp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestLicenses(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          string
+		suggestions []string
+	}{
+		{"missing hyphen and minor version", "Apache2", []string{"Apache-2.0"}},
+		{"missing minor version", "apache-2", []string{"Apache-2.0"}},
+		{"version with v", "GPLv2", []string{"GPL-2.0-only", "GPL-2.0-or-later"}},
+		{"missing BSD clause", "BSD3", []string{"BSD-3-Clause"}},
+		{"typo table", "BDS-3-Clause", []string{"BSD-3-Clause", "BSD-1-Clause", "BSD-2-Clause", "BSD-4-Clause", "DEC-3-Clause"}},
+		{"transposed letters", "MTI", []string{"MIT"}},
+		{"british spelling", "Unlicence", []string{"Unlicense"}},
+		{"alias", "Expat", []string{"MIT"}},
+		{"missing version", "Apache", []string{"Apache-2.0", "Apache-1.0", "Apache-1.1"}},
+		{"exception", "Classpath-exception", []string{"Classpath-exception-2.0", "Classpath-exception-2.0-short"}},
+		{"active preferred over deprecated", "LGPL2.1", []string{"LGPL-2.1-only", "LGPL-2.1-or-later", "LGPL-2.1", "LGPL-2.0", "LGPL-2.1+"}},
+		{"no similar license", "BOGUS-LICENSE", []string{}},
+		{"empty", "", nil},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.suggestions, suggestLicenses(test.id, nil))
+		})
+	}
+}
+
+func TestParseErrorSuggestions(t *testing.T) {
+	_, err := Parse("MIT OR Apache2")
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, []string{"Apache-2.0"}, parseErr.Suggestions())
+
+	// only unknown licenses have suggestions
+	_, err = Parse("MIT OR")
+	require.True(t, errors.As(err, &parseErr))
+	assert.Nil(t, parseErr.Suggestions())
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		limit    int
+		distance int
+	}{
+		{"MIT", "MIT", 2, 0},
+		{"MIT", "MTI", 2, 1},
+		{"MIT", "MITS", 2, 1},
+		{"APACHE", "APAHCE", 2, 1},
+		{"GPL", "LGPL", 2, 1},
+		{"ISC", "BSD", 3, 2},
+		{"ISC", "BSD", 1, 2},
+		{"MIT", "APACHE-2.0", 3, 4},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.a+" "+test.b, func(t *testing.T) {
+			assert.Equal(t, test.distance, editDistance(test.a, test.b, test.limit))
+		})
+	}
+}